@@ -8,10 +8,12 @@ type AccessTokensService struct {
 	client *Client
 }
 
-// Token an oauth access token for the buildkite service
+// Token an oauth access token for the buildkite service, as returned by the
+// token exchange in the oauth package. Use it with WithOAuthToken.
 type Token struct {
 	AccessToken string `json:"access_token,omitempty"`
 	Type        string `json:"token_type,omitempty"`
+	Scope       string `json:"scope,omitempty"` // space-separated scopes granted to the token
 }
 
 type AccessToken struct {
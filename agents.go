@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // AgentsService handles communication with the agent related
@@ -92,6 +93,14 @@ func (as *AgentsService) List(ctx context.Context, org string, opt *AgentListOpt
 	return agents, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (as *AgentsService) ListAll(ctx context.Context, org string, opt *AgentListOptions) iter.Seq2[Agent, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *AgentListOptions) ([]Agent, *Response, error) {
+		return as.List(ctx, org, opt)
+	})
+}
+
 // Get fetches an agent.
 //
 // buildkite API docs: https://buildkite.com/docs/api/agents#get-an-agent
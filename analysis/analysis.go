@@ -0,0 +1,298 @@
+// Package analysis reconstructs the dependency graph of a build's jobs and
+// measures where the build's time went: its critical path, how long each job
+// waited to start against how long it ran, and the agent time it used.
+//
+// The REST API doesn't expose the depends_on of a job's step, so the graph is
+// inferred. Jobs after a wait or block step depend on the jobs before it, and
+// jobs added by a pipeline upload depend on the job that uploaded them.
+// Explicit dependencies between steps in the same phase of a build, and input
+// steps, which unlike block steps don't hold back later steps, aren't
+// represented.
+//
+//	g, err := analysis.Fetch(ctx, client, "acme", "web", "42")
+//	path := g.CriticalPath()
+//	for _, n := range path.Nodes {
+//		fmt.Printf("%-40s waited %v, ran %v\n", n.Job.Label, n.Wait(), n.Run())
+//	}
+package analysis
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+// Node is a job in a build's dependency graph.
+type Node struct {
+	Job buildkite.Job
+
+	// DependsOn are the jobs that had to finish before this one could run,
+	// and Dependents the jobs that waited for this one, in build order.
+	DependsOn  []*Node
+	Dependents []*Node
+
+	index int // position in Graph.Nodes
+}
+
+// Wait returns how long the job waited to start once it could run: from
+// RunnableAt to StartedAt, such as while waiting for an agent, or to
+// UnblockedAt for a block step. It is 0 if either time is unknown.
+func (n *Node) Wait() time.Duration {
+	end := n.Job.StartedAt
+	if n.Job.Type == buildkite.JobTypeManual {
+		end = n.Job.UnblockedAt
+	}
+	return between(n.Job.RunnableAt, end)
+}
+
+// Run returns how long the job ran, from StartedAt to FinishedAt. It is 0 if
+// either time is unknown, such as for a job that hasn't finished.
+func (n *Node) Run() time.Duration {
+	return between(n.Job.StartedAt, n.Job.FinishedAt)
+}
+
+// between returns the time from start to end, or 0 if either is unknown.
+func between(start, end *buildkite.Timestamp) time.Duration {
+	if start == nil || end == nil {
+		return 0
+	}
+	return max(end.Sub(start.Time), 0)
+}
+
+// Graph is the dependency graph of a build's jobs.
+type Graph struct {
+	// Nodes are the build's jobs in build order, which is also a topological
+	// order: every job comes after those it depends on. Jobs that were
+	// retried are left out in favour of their last retry.
+	Nodes []*Node
+
+	// Retried are the attempts at jobs that were retried, which count towards
+	// AgentTime but aren't part of the graph.
+	Retried []buildkite.Job
+}
+
+// NewGraph infers the dependency graph of build's jobs, using its step
+// uploads, if known, to find the jobs added by each pipeline upload.
+func NewGraph(build buildkite.Build, uploads []buildkite.StepUpload) *Graph {
+	g := &Graph{}
+	byID := map[string]*Node{}
+	for _, job := range build.Jobs {
+		if job.Retried {
+			g.Retried = append(g.Retried, job)
+			continue
+		}
+		n := &Node{Job: job, index: len(g.Nodes)}
+		g.Nodes = append(g.Nodes, n)
+		byID[job.ID] = n
+	}
+
+	// Wait and block steps divide the build into phases. A barrier depends
+	// on every job in the phase before it, or on the previous barrier if the
+	// phase is empty, and every job in the phase after it depends on it.
+	var barrier *Node
+	var phase []*Node
+	for _, n := range g.Nodes {
+		switch n.Job.Type {
+		case buildkite.JobTypeWaiter, buildkite.JobTypeManual:
+			if len(phase) == 0 && barrier != nil {
+				phase = []*Node{barrier}
+			}
+			for _, dep := range phase {
+				link(dep, n)
+			}
+			barrier, phase = n, nil
+		default:
+			if barrier != nil {
+				link(barrier, n)
+			}
+			phase = append(phase, n)
+		}
+	}
+
+	// Each applied upload added CreatedJobsCount jobs, which are taken to be
+	// the first jobs after its source job that were created once it was, and
+	// not already claimed by an earlier upload. Retries weren't added by an
+	// upload, but stand in for the job they retried.
+	retries := map[string]bool{}
+	for _, job := range build.Jobs {
+		if job.RetriedInJobID != "" {
+			retries[job.RetriedInJobID] = true
+		}
+	}
+	latest := func(job buildkite.Job) *Node {
+		for i := 0; i < len(build.Jobs) && byID[job.ID] == nil; i++ {
+			next := slices.IndexFunc(build.Jobs, func(j buildkite.Job) bool { return j.ID == job.RetriedInJobID })
+			if next < 0 {
+				return nil
+			}
+			job = build.Jobs[next]
+		}
+		return byID[job.ID]
+	}
+
+	uploads = slices.Clone(uploads)
+	slices.SortStableFunc(uploads, func(a, b buildkite.StepUpload) int {
+		return compareTimestamps(a.CreatedAt, b.CreatedAt)
+	})
+	claimed := map[string]bool{}
+	for _, u := range uploads {
+		i := slices.IndexFunc(build.Jobs, func(j buildkite.Job) bool { return j.ID == u.SourceJobID })
+		if i < 0 || u.CreatedJobsCount == nil {
+			continue
+		}
+		source := latest(build.Jobs[i])
+		remaining := *u.CreatedJobsCount
+		for _, job := range build.Jobs[i+1:] {
+			if remaining == 0 {
+				break
+			}
+			if retries[job.ID] || claimed[job.ID] || compareTimestamps(job.CreatedAt, u.CreatedAt) < 0 {
+				continue
+			}
+			claimed[job.ID] = true
+			remaining--
+			if n := latest(job); source != nil && n != nil {
+				link(source, n)
+			}
+		}
+	}
+
+	return g
+}
+
+// compareTimestamps orders timestamps, with unknown times first.
+func compareTimestamps(a, b *buildkite.Timestamp) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return a.Compare(b.Time)
+}
+
+// link records that n depends on dep, unless it already does.
+func link(dep, n *Node) {
+	if dep.index >= n.index || slices.Contains(n.DependsOn, dep) {
+		return
+	}
+	n.DependsOn = append(n.DependsOn, dep)
+	slices.SortFunc(n.DependsOn, func(a, b *Node) int { return cmp.Compare(a.index, b.index) })
+	dep.Dependents = append(dep.Dependents, n)
+	slices.SortFunc(dep.Dependents, func(a, b *Node) int { return cmp.Compare(a.index, b.index) })
+}
+
+// Node returns the node of the job with the given ID, or nil if the graph has
+// none.
+func (g *Graph) Node(jobID string) *Node {
+	for _, n := range g.Nodes {
+		if n.Job.ID == jobID {
+			return n
+		}
+	}
+	return nil
+}
+
+// Path is a chain of jobs through a Graph, each depending on the one before.
+type Path struct {
+	Nodes []*Node
+
+	// Wait and Run are the totals of the Wait and Run of the path's jobs.
+	Wait time.Duration
+	Run  time.Duration
+}
+
+// Duration returns the total time the path's jobs spent waiting and running.
+func (p Path) Duration() time.Duration {
+	return p.Wait + p.Run
+}
+
+// CriticalPath returns the chain of dependent jobs that took longest, counting
+// both the time each job waited to start and the time it ran. Shortening the
+// build means shortening this path.
+func (g *Graph) CriticalPath() Path {
+	if len(g.Nodes) == 0 {
+		return Path{}
+	}
+
+	// Nodes are in topological order, so each node's dependencies have their
+	// longest paths computed before it.
+	longest := make([]time.Duration, len(g.Nodes))
+	prev := make([]*Node, len(g.Nodes))
+	end := g.Nodes[0]
+	for _, n := range g.Nodes {
+		for _, dep := range n.DependsOn {
+			if prev[n.index] == nil || longest[dep.index] > longest[prev[n.index].index] {
+				prev[n.index] = dep
+			}
+		}
+		if p := prev[n.index]; p != nil {
+			longest[n.index] = longest[p.index]
+		}
+		longest[n.index] += n.Wait() + n.Run()
+		if longest[n.index] > longest[end.index] {
+			end = n
+		}
+	}
+
+	var path Path
+	for n := end; n != nil; n = prev[n.index] {
+		path.Nodes = append(path.Nodes, n)
+		path.Wait += n.Wait()
+		path.Run += n.Run()
+	}
+	slices.Reverse(path.Nodes)
+	return path
+}
+
+// AgentTime returns the total time the build's jobs ran on agents, including
+// attempts at jobs that were later retried.
+func (g *Graph) AgentTime() time.Duration {
+	var total time.Duration
+	for _, n := range g.Nodes {
+		if n.Job.Type == buildkite.JobTypeScript {
+			total += n.Run()
+		}
+	}
+	for _, job := range g.Retried {
+		if job.Type == buildkite.JobTypeScript {
+			total += between(job.StartedAt, job.FinishedAt)
+		}
+	}
+	return total
+}
+
+// Fetch gets a build, including its retried jobs, and its step uploads, and
+// returns the build's graph. For a build past its maximum lifetime, whose step
+// uploads are gone, the graph is inferred without them.
+func Fetch(ctx context.Context, api buildkite.ClientAPI, org, pipeline, buildNumber string) (*Graph, error) {
+	build, _, err := api.BuildsAPI().Get(ctx, org, pipeline, buildNumber, &buildkite.BuildGetOptions{
+		BuildsListOptions: buildkite.BuildsListOptions{IncludeRetriedJobs: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting build: %w", err)
+	}
+
+	var uploads []buildkite.StepUpload
+	for u, err := range api.StepUploadsAPI().ListByBuildAll(ctx, org, pipeline, buildNumber, nil) {
+		if errors.Is(err, buildkite.ErrGone) {
+			// Builds past their maximum lifetime have no step uploads to
+			// list, so the graph is inferred from job order alone.
+			uploads = nil
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing step uploads: %w", err)
+		}
+		uploads = append(uploads, u)
+	}
+
+	return NewGraph(build, uploads), nil
+}
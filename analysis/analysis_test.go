@@ -0,0 +1,223 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5"
+	"github.com/buildkite/go-buildkite/v5/buildkitefake"
+	"github.com/google/go-cmp/cmp"
+)
+
+var start = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// at returns the timestamp s seconds into the build.
+func at(s int) *buildkite.Timestamp {
+	return buildkite.NewTimestamp(start.Add(time.Duration(s) * time.Second))
+}
+
+// script returns a command job created at created that became runnable at
+// runnable, started at started and finished at finished.
+func script(id string, created, runnable, started, finished int) buildkite.Job {
+	return buildkite.Job{
+		ID:         id,
+		Type:       buildkite.JobTypeScript,
+		CreatedAt:  at(created),
+		RunnableAt: at(runnable),
+		StartedAt:  at(started),
+		FinishedAt: at(finished),
+	}
+}
+
+// count returns a pointer to n, for StepUpload.CreatedJobsCount.
+func count(n int) *int { return &n }
+
+// ids returns the job IDs of nodes.
+func ids(nodes []*Node) []string {
+	var out []string
+	for _, n := range nodes {
+		out = append(out, n.Job.ID)
+	}
+	return out
+}
+
+// testBuild is a build with a pipeline upload of four steps: two parallel jobs,
+// one of them retried, a wait, and a job that uploads one more job.
+func testBuild() (buildkite.Build, []buildkite.StepUpload) {
+	retried := script("test-1", 2, 2, 3, 5)
+	retried.Retried = true
+	retried.RetriedInJobID = "test-2"
+	build := buildkite.Build{
+		Jobs: []buildkite.Job{
+			script("upload", 0, 0, 1, 2),
+			retried,
+			script("test-2", 5, 5, 6, 20),
+			script("lint", 2, 2, 4, 8),
+			{ID: "wait", Type: buildkite.JobTypeWaiter, CreatedAt: at(2)},
+			script("deploy", 2, 20, 25, 30),
+			script("smoke", 28, 30, 31, 40),
+		},
+	}
+	uploads := []buildkite.StepUpload{
+		{SourceJobID: "deploy", CreatedJobsCount: count(1), CreatedAt: at(28)},
+		{SourceJobID: "upload", CreatedJobsCount: count(4), CreatedAt: at(2)},
+		{SourceJobID: "lint", State: buildkite.StepUploadStateRejected, CreatedAt: at(7)},
+	}
+	return build, uploads
+}
+
+func TestNewGraph(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph(testBuild())
+
+	if diff := cmp.Diff([]string{"upload", "test-2", "lint", "wait", "deploy", "smoke"}, ids(g.Nodes)); diff != "" {
+		t.Errorf("Nodes diff: (-want +got)\n%s", diff)
+	}
+	if len(g.Retried) != 1 || g.Retried[0].ID != "test-1" {
+		t.Errorf("Retried = %+v, want test-1", g.Retried)
+	}
+
+	deps := map[string][]string{
+		"upload": nil,
+		"test-2": {"upload"},
+		"lint":   {"upload"},
+		"wait":   {"upload", "test-2", "lint"},
+		"deploy": {"upload", "wait"},
+		"smoke":  {"wait", "deploy"},
+	}
+	for id, want := range deps {
+		if diff := cmp.Diff(want, ids(g.Node(id).DependsOn)); diff != "" {
+			t.Errorf("%s DependsOn diff: (-want +got)\n%s", id, diff)
+		}
+	}
+	if diff := cmp.Diff([]string{"test-2", "lint", "wait", "deploy"}, ids(g.Node("upload").Dependents)); diff != "" {
+		t.Errorf("upload Dependents diff: (-want +got)\n%s", diff)
+	}
+	if g.Node("test-1") != nil {
+		t.Error("Node(test-1) found a retried job")
+	}
+}
+
+func TestNewGraph_ConsecutiveBarriers(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph(buildkite.Build{Jobs: []buildkite.Job{
+		script("a", 0, 0, 0, 1),
+		{ID: "wait-1", Type: buildkite.JobTypeWaiter},
+		{ID: "block", Type: buildkite.JobTypeManual, RunnableAt: at(1), UnblockedAt: at(61)},
+		script("b", 0, 61, 62, 70),
+	}}, nil)
+
+	if diff := cmp.Diff([]string{"wait-1"}, ids(g.Node("block").DependsOn)); diff != "" {
+		t.Errorf("block DependsOn diff: (-want +got)\n%s", diff)
+	}
+	if got := g.Node("block").Wait(); got != time.Minute {
+		t.Errorf("block Wait() = %v, want 1m", got)
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph(testBuild())
+	path := g.CriticalPath()
+
+	if diff := cmp.Diff([]string{"upload", "test-2", "wait", "deploy", "smoke"}, ids(path.Nodes)); diff != "" {
+		t.Errorf("CriticalPath diff: (-want +got)\n%s", diff)
+	}
+	if path.Wait != 8*time.Second || path.Run != 29*time.Second || path.Duration() != 37*time.Second {
+		t.Errorf("CriticalPath Wait, Run, Duration = %v, %v, %v, want 8s, 29s, 37s", path.Wait, path.Run, path.Duration())
+	}
+
+	if got := (&Graph{}).CriticalPath(); len(got.Nodes) != 0 {
+		t.Errorf("empty graph CriticalPath = %+v", got)
+	}
+}
+
+func TestNode_WaitAndRun(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph(testBuild())
+	n := g.Node("deploy")
+	if n.Wait() != 5*time.Second || n.Run() != 5*time.Second {
+		t.Errorf("deploy Wait, Run = %v, %v, want 5s, 5s", n.Wait(), n.Run())
+	}
+
+	unfinished := &Node{Job: buildkite.Job{RunnableAt: at(0), StartedAt: at(3)}}
+	if unfinished.Wait() != 3*time.Second || unfinished.Run() != 0 {
+		t.Errorf("unfinished Wait, Run = %v, %v, want 3s, 0s", unfinished.Wait(), unfinished.Run())
+	}
+}
+
+func TestAgentTime(t *testing.T) {
+	t.Parallel()
+
+	// 1 + 14 + 4 + 5 + 9 from the graph, and 2 from the retried job.
+	if got := NewGraph(testBuild()).AgentTime(); got != 35*time.Second {
+		t.Errorf("AgentTime() = %v, want 35s", got)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+
+	build, uploads := testBuild()
+	client := buildkitefake.NewClient()
+	client.Builds.GetFunc = func(ctx context.Context, org, pipeline, id string, opt *buildkite.BuildGetOptions) (buildkite.Build, *buildkite.Response, error) {
+		if opt == nil || !opt.IncludeRetriedJobs {
+			t.Error("Get didn't include retried jobs")
+		}
+		return build, nil, nil
+	}
+	client.StepUploads.ListByBuildAllFunc = func(ctx context.Context, org, pipeline, buildNumber string, opt *buildkite.StepUploadsListOptions) iter.Seq2[buildkite.StepUpload, error] {
+		return func(yield func(buildkite.StepUpload, error) bool) {
+			for _, u := range uploads {
+				if !yield(u, nil) {
+					return
+				}
+			}
+		}
+	}
+
+	g, err := Fetch(context.Background(), client, "acme", "web", "42")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if diff := cmp.Diff([]string{"wait", "deploy"}, ids(g.Node("smoke").DependsOn)); diff != "" {
+		t.Errorf("smoke DependsOn diff: (-want +got)\n%s", diff)
+	}
+
+	client.StepUploads.ListByBuildAllFunc = nil
+	if _, err := Fetch(context.Background(), client, "acme", "web", "42"); err == nil {
+		t.Error("Fetch succeeded without step uploads")
+	}
+}
+
+func TestFetch_StepUploadsGone(t *testing.T) {
+	t.Parallel()
+
+	build, _ := testBuild()
+	client := buildkitefake.NewClient()
+	client.Builds.GetFunc = func(ctx context.Context, org, pipeline, id string, opt *buildkite.BuildGetOptions) (buildkite.Build, *buildkite.Response, error) {
+		return build, nil, nil
+	}
+	client.StepUploads.ListByBuildAllFunc = func(ctx context.Context, org, pipeline, buildNumber string, opt *buildkite.StepUploadsListOptions) iter.Seq2[buildkite.StepUpload, error] {
+		return func(yield func(buildkite.StepUpload, error) bool) {
+			yield(buildkite.StepUpload{}, fmt.Errorf("GET step-uploads: %w", buildkite.ErrGone))
+		}
+	}
+
+	g, err := Fetch(context.Background(), client, "acme", "web", "42")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+
+	// Without the uploads, nothing shows that deploy added smoke.
+	if diff := cmp.Diff([]string{"wait"}, ids(g.Node("smoke").DependsOn)); diff != "" {
+		t.Errorf("smoke DependsOn diff: (-want +got)\n%s", diff)
+	}
+}
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -66,6 +67,14 @@ func (as *AnnotationsService) ListByBuild(ctx context.Context, org string, pipel
 	return annotations, resp, err
 }
 
+// ListByBuildAll is like ListByBuild but returns an iterator over the results
+// from every page, fetching each page as iteration reaches it.
+func (as *AnnotationsService) ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+		return as.ListByBuild(ctx, org, pipeline, build, opt)
+	})
+}
+
 func (as *AnnotationsService) Create(ctx context.Context, org, pipeline, build string, ac AnnotationCreate) (Annotation, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/pipelines/%s/builds/%s/annotations", org, pipeline, build)
 	req, err := as.client.NewRequest(ctx, "POST", u, ac)
@@ -115,6 +124,14 @@ func (as *AnnotationsService) ListByJob(ctx context.Context, org, pipeline, buil
 	return annotations, resp, err
 }
 
+// ListByJobAll is like ListByJob but returns an iterator over the results from
+// every page, fetching each page as iteration reaches it.
+func (as *AnnotationsService) ListByJobAll(ctx context.Context, org, pipeline, build, jobID string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+		return as.ListByJob(ctx, org, pipeline, build, jobID, opt)
+	})
+}
+
 // CreateForJob creates an annotation scoped to a specific job
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/annotations#create-an-annotation-on-a-job
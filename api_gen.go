@@ -0,0 +1,618 @@
+// Code generated by internal/cmd/genapi; DO NOT EDIT.
+
+package buildkite
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// AccessTokensAPI is the interface implemented by AccessTokensService.
+type AccessTokensAPI interface {
+	Get(ctx context.Context) (AccessToken, *Response, error)
+	Revoke(ctx context.Context) (*Response, error)
+}
+
+var _ AccessTokensAPI = (*AccessTokensService)(nil)
+
+// AgentsAPI is the interface implemented by AgentsService.
+type AgentsAPI interface {
+	Create(ctx context.Context, org string, agent Agent) (Agent, *Response, error)
+	Delete(ctx context.Context, org string, id string) (*Response, error)
+	Get(ctx context.Context, org string, id string) (Agent, *Response, error)
+	List(ctx context.Context, org string, opt *AgentListOptions) ([]Agent, *Response, error)
+	ListAll(ctx context.Context, org string, opt *AgentListOptions) iter.Seq2[Agent, error]
+	Pause(ctx context.Context, org string, id string, opts *AgentPauseOptions) (*Response, error)
+	Resume(ctx context.Context, org string, id string) (*Response, error)
+	Stop(ctx context.Context, org string, id string, force bool) (*Response, error)
+}
+
+var _ AgentsAPI = (*AgentsService)(nil)
+
+// AnnotationsAPI is the interface implemented by AnnotationsService.
+type AnnotationsAPI interface {
+	Create(ctx context.Context, org string, pipeline string, build string, ac AnnotationCreate) (Annotation, *Response, error)
+	CreateForJob(ctx context.Context, org string, pipeline string, build string, jobID string, ac AnnotationCreate) (Annotation, *Response, error)
+	Delete(ctx context.Context, org string, pipeline string, build string, annotationUUID string) (*Response, error)
+	DeleteForJob(ctx context.Context, org string, pipeline string, build string, jobID string, annotationUUID string) (*Response, error)
+	ListByBuild(ctx context.Context, org string, pipeline string, build string, opt *AnnotationListOptions) ([]Annotation, *Response, error)
+	ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *AnnotationListOptions) iter.Seq2[Annotation, error]
+	ListByJob(ctx context.Context, org string, pipeline string, build string, jobID string, opt *AnnotationListOptions) ([]Annotation, *Response, error)
+	ListByJobAll(ctx context.Context, org string, pipeline string, build string, jobID string, opt *AnnotationListOptions) iter.Seq2[Annotation, error]
+}
+
+var _ AnnotationsAPI = (*AnnotationsService)(nil)
+
+// ArtifactsAPI is the interface implemented by ArtifactsService.
+type ArtifactsAPI interface {
+	Delete(ctx context.Context, org string, pipeline string, build string, job string, id string) (*Response, error)
+	DownloadArtifactByURL(ctx context.Context, url string, w io.Writer) (*Response, error)
+	Get(ctx context.Context, org string, pipeline string, build string, job string, id string) (Artifact, *Response, error)
+	ListByBuild(ctx context.Context, org string, pipeline string, build string, opt *ArtifactListOptions) ([]Artifact, *Response, error)
+	ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *ArtifactListOptions) iter.Seq2[Artifact, error]
+	ListByJob(ctx context.Context, org string, pipeline string, build string, job string, opt *ArtifactListOptions) ([]Artifact, *Response, error)
+	ListByJobAll(ctx context.Context, org string, pipeline string, build string, job string, opt *ArtifactListOptions) iter.Seq2[Artifact, error]
+}
+
+var _ ArtifactsAPI = (*ArtifactsService)(nil)
+
+// BuildTestsAPI is the interface implemented by BuildTestsService.
+type BuildTestsAPI interface {
+	List(ctx context.Context, org string, buildUUID string, opt *BuildTestsListOptions) ([]TestWithMetrics, *Response, error)
+	ListAll(ctx context.Context, org string, buildUUID string, opt *BuildTestsListOptions) iter.Seq2[TestWithMetrics, error]
+}
+
+var _ BuildTestsAPI = (*BuildTestsService)(nil)
+
+// BuildsAPI is the interface implemented by BuildsService.
+type BuildsAPI interface {
+	BulkCancel(ctx context.Context, org string, pipeline string, opt *BulkOptions) (BulkReport, error)
+	BulkRebuild(ctx context.Context, org string, pipeline string, opt *BulkOptions) (BulkReport, error)
+	Cancel(ctx context.Context, org string, pipeline string, buildNumber string) (Build, error)
+	Create(ctx context.Context, org string, pipeline string, b CreateBuild) (Build, *Response, error)
+	CreateAndWait(ctx context.Context, org string, pipeline string, b CreateBuild, opt *WaitForBuildOptions) (Build, BuildOutcome, error)
+	Get(ctx context.Context, org string, pipeline string, buildNumber string, opt *BuildGetOptions) (Build, *Response, error)
+	List(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error)
+	ListAll(ctx context.Context, opt *BuildsListOptions) iter.Seq2[Build, error]
+	ListByOrg(ctx context.Context, org string, opt *BuildsListOptions) ([]Build, *Response, error)
+	ListByOrgAll(ctx context.Context, org string, opt *BuildsListOptions) iter.Seq2[Build, error]
+	ListByPipeline(ctx context.Context, org string, pipeline string, opt *BuildsListOptions) ([]Build, *Response, error)
+	ListByPipelineAll(ctx context.Context, org string, pipeline string, opt *BuildsListOptions) iter.Seq2[Build, error]
+	Rebuild(ctx context.Context, org string, pipeline string, buildNumber string) (Build, error)
+	Tree(ctx context.Context, org string, pipeline string, buildNumber string, opt *BuildTreeOptions) (*BuildTree, error)
+	WaitForBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *WaitForBuildOptions) (Build, BuildOutcome, error)
+}
+
+var _ BuildsAPI = (*BuildsService)(nil)
+
+// ClusterMaintainersAPI is the interface implemented by ClusterMaintainersService.
+type ClusterMaintainersAPI interface {
+	Create(ctx context.Context, org string, clusterID string, input ClusterMaintainer) (ClusterMaintainerEntry, *Response, error)
+	Delete(ctx context.Context, org string, clusterID string, id string) (*Response, error)
+	Get(ctx context.Context, org string, clusterID string, id string) (ClusterMaintainerEntry, *Response, error)
+	List(ctx context.Context, org string, clusterID string, opt *ClusterMaintainersListOptions) ([]ClusterMaintainerEntry, *Response, error)
+	ListAll(ctx context.Context, org string, clusterID string, opt *ClusterMaintainersListOptions) iter.Seq2[ClusterMaintainerEntry, error]
+}
+
+var _ ClusterMaintainersAPI = (*ClusterMaintainersService)(nil)
+
+// ClusterQueuesAPI is the interface implemented by ClusterQueuesService.
+type ClusterQueuesAPI interface {
+	Create(ctx context.Context, org string, clusterID string, qc ClusterQueueCreate) (ClusterQueue, *Response, error)
+	Delete(ctx context.Context, org string, clusterID string, queueID string) (*Response, error)
+	Get(ctx context.Context, org string, clusterID string, queueID string) (ClusterQueue, *Response, error)
+	List(ctx context.Context, org string, clusterID string, opt *ClusterQueuesListOptions) ([]ClusterQueue, *Response, error)
+	ListAll(ctx context.Context, org string, clusterID string, opt *ClusterQueuesListOptions) iter.Seq2[ClusterQueue, error]
+	Pause(ctx context.Context, org string, clusterID string, queueID string, qp ClusterQueuePause) (ClusterQueue, *Response, error)
+	Resume(ctx context.Context, org string, clusterID string, queueID string) (*Response, error)
+	Update(ctx context.Context, org string, clusterID string, queueID string, qu ClusterQueueUpdate) (ClusterQueue, *Response, error)
+}
+
+var _ ClusterQueuesAPI = (*ClusterQueuesService)(nil)
+
+// ClusterSecretsAPI is the interface implemented by ClusterSecretsService.
+type ClusterSecretsAPI interface {
+	Create(ctx context.Context, org string, clusterID string, input ClusterSecretCreate) (ClusterSecret, *Response, error)
+	Delete(ctx context.Context, org string, clusterID string, secretID string) (*Response, error)
+	Get(ctx context.Context, org string, clusterID string, secretID string) (ClusterSecret, *Response, error)
+	List(ctx context.Context, org string, clusterID string, opt *ClusterSecretsListOptions) ([]ClusterSecret, *Response, error)
+	ListAll(ctx context.Context, org string, clusterID string, opt *ClusterSecretsListOptions) iter.Seq2[ClusterSecret, error]
+	Update(ctx context.Context, org string, clusterID string, secretID string, input ClusterSecretUpdate) (ClusterSecret, *Response, error)
+	UpdateValue(ctx context.Context, org string, clusterID string, secretID string, input ClusterSecretValueUpdate) (*Response, error)
+}
+
+var _ ClusterSecretsAPI = (*ClusterSecretsService)(nil)
+
+// ClusterTokensAPI is the interface implemented by ClusterTokensService.
+type ClusterTokensAPI interface {
+	Create(ctx context.Context, org string, clusterID string, ctc ClusterTokenCreate) (ClusterToken, *Response, error)
+	Delete(ctx context.Context, org string, clusterID string, tokenID string) (*Response, error)
+	Get(ctx context.Context, org string, clusterID string, tokenID string) (ClusterToken, *Response, error)
+	List(ctx context.Context, org string, clusterID string, opt *ClusterTokensListOptions) ([]ClusterToken, *Response, error)
+	ListAll(ctx context.Context, org string, clusterID string, opt *ClusterTokensListOptions) iter.Seq2[ClusterToken, error]
+	Update(ctx context.Context, org string, clusterID string, tokenID string, ctc ClusterTokenUpdate) (ClusterToken, *Response, error)
+}
+
+var _ ClusterTokensAPI = (*ClusterTokensService)(nil)
+
+// ClustersAPI is the interface implemented by ClustersService.
+type ClustersAPI interface {
+	Create(ctx context.Context, org string, cc ClusterCreate) (Cluster, *Response, error)
+	Delete(ctx context.Context, org string, id string) (*Response, error)
+	Get(ctx context.Context, org string, id string) (Cluster, *Response, error)
+	List(ctx context.Context, org string, opt *ClustersListOptions) ([]Cluster, *Response, error)
+	ListAll(ctx context.Context, org string, opt *ClustersListOptions) iter.Seq2[Cluster, error]
+	Update(ctx context.Context, org string, id string, cu ClusterUpdate) (Cluster, *Response, error)
+}
+
+var _ ClustersAPI = (*ClustersService)(nil)
+
+// EmojisAPI is the interface implemented by EmojisService.
+type EmojisAPI interface {
+	List(ctx context.Context, org string) ([]Emoji, *Response, error)
+}
+
+var _ EmojisAPI = (*EmojisService)(nil)
+
+// FlakyTestsAPI is the interface implemented by FlakyTestsService.
+type FlakyTestsAPI interface {
+	List(ctx context.Context, org string, slug string, opt *FlakyTestsListOptions) ([]FlakyTest, *Response, error)
+	ListAll(ctx context.Context, org string, slug string, opt *FlakyTestsListOptions) iter.Seq2[FlakyTest, error]
+}
+
+var _ FlakyTestsAPI = (*FlakyTestsService)(nil)
+
+// GraphQLAPI is the interface implemented by GraphQLService.
+type GraphQLAPI interface {
+	Do(ctx context.Context, query string, vars map[string]any, out any) (*Response, error)
+}
+
+var _ GraphQLAPI = (*GraphQLService)(nil)
+
+// JobsAPI is the interface implemented by JobsService.
+type JobsAPI interface {
+	DeleteJobLog(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (*Response, error)
+	GetJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (Job, *Response, error)
+	GetJobByOrg(ctx context.Context, org string, jobID string) (Job, *Response, error)
+	GetJobEnvironmentVariables(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (JobEnvs, *Response, error)
+	GetJobLog(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (JobLog, *Response, error)
+	JobLogExists(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (bool, *Response, error)
+	ListByBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *JobsListOptions) (JobsList, *Response, error)
+	ListByBuildAll(ctx context.Context, org string, pipeline string, buildNumber string, opt *JobsListOptions) iter.Seq2[Job, error]
+	ReprioritizeJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *JobReprioritizationOptions) (Job, *Response, error)
+	RetryJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (Job, *Response, error)
+	UnblockJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *JobUnblockOptions) (Job, *Response, error)
+}
+
+var _ JobsAPI = (*JobsService)(nil)
+
+// MembersAPI is the interface implemented by MembersService.
+type MembersAPI interface {
+	Get(ctx context.Context, org string, memberUUID string) (Member, *Response, error)
+	List(ctx context.Context, org string, opt *MemberListOptions) ([]Member, *Response, error)
+	ListAll(ctx context.Context, org string, opt *MemberListOptions) iter.Seq2[Member, error]
+}
+
+var _ MembersAPI = (*MembersService)(nil)
+
+// MetaAPI is the interface implemented by MetaService.
+type MetaAPI interface {
+	Get(ctx context.Context) (Meta, *Response, error)
+}
+
+var _ MetaAPI = (*MetaService)(nil)
+
+// OrganizationsAPI is the interface implemented by OrganizationsService.
+type OrganizationsAPI interface {
+	Get(ctx context.Context, slug string) (Organization, *Response, error)
+	List(ctx context.Context, opt *OrganizationListOptions) ([]Organization, *Response, error)
+	ListAll(ctx context.Context, opt *OrganizationListOptions) iter.Seq2[Organization, error]
+}
+
+var _ OrganizationsAPI = (*OrganizationsService)(nil)
+
+// PackageRegistriesAPI is the interface implemented by PackageRegistriesService.
+type PackageRegistriesAPI interface {
+	Create(ctx context.Context, organizationSlug string, cpri CreatePackageRegistryInput) (PackageRegistry, *Response, error)
+	Delete(ctx context.Context, organizationSlug string, registrySlug string) (*Response, error)
+	Get(ctx context.Context, organizationSlug string, registrySlug string) (PackageRegistry, *Response, error)
+	List(ctx context.Context, organizationSlug string) ([]PackageRegistry, *Response, error)
+	ListPackages(ctx context.Context, organizationSlug string, registrySlug string, opts *RegistryPackagesOptions) (RegistryPackages, *Response, error)
+	ListPackagesAll(ctx context.Context, organizationSlug string, registrySlug string, opts *RegistryPackagesOptions) iter.Seq2[Package, error]
+	Update(ctx context.Context, organizationSlug string, registrySlug string, upri UpdatePackageRegistryInput) (PackageRegistry, *Response, error)
+}
+
+var _ PackageRegistriesAPI = (*PackageRegistriesService)(nil)
+
+// PackageRegistryTokensAPI is the interface implemented by PackageRegistryTokensService.
+type PackageRegistryTokensAPI interface {
+	Create(ctx context.Context, organizationSlug string, registrySlug string, input CreatePackageRegistryTokenInput) (PackageRegistryToken, *Response, error)
+	Delete(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (*Response, error)
+	Get(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (PackageRegistryToken, *Response, error)
+	List(ctx context.Context, organizationSlug string, registrySlug string) ([]PackageRegistryToken, *Response, error)
+	Update(ctx context.Context, organizationSlug string, registrySlug string, tokenID string, input UpdatePackageRegistryTokenInput) (PackageRegistryToken, *Response, error)
+}
+
+var _ PackageRegistryTokensAPI = (*PackageRegistryTokensService)(nil)
+
+// PackagesAPI is the interface implemented by PackagesService.
+type PackagesAPI interface {
+	Copy(ctx context.Context, organizationSlug string, sourceRegistrySlug string, packageID string, destinationRegistrySlug string) (Package, *Response, error)
+	Create(ctx context.Context, organizationSlug string, registrySlug string, cpi CreatePackageInput) (Package, *Response, error)
+	Delete(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (*Response, error)
+	Get(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (Package, *Response, error)
+	RequestPresignedUpload(ctx context.Context, organizationSlug string, registrySlug string) (*PackagePresignedUpload, *Response, error)
+}
+
+var _ PackagesAPI = (*PackagesService)(nil)
+
+// PipelineSchedulesAPI is the interface implemented by PipelineSchedulesService.
+type PipelineSchedulesAPI interface {
+	Create(ctx context.Context, org string, pipelineSlug string, in CreatePipelineSchedule) (PipelineSchedule, *Response, error)
+	Delete(ctx context.Context, org string, pipelineSlug string, id string) (*Response, error)
+	Get(ctx context.Context, org string, pipelineSlug string, id string) (PipelineSchedule, *Response, error)
+	List(ctx context.Context, org string, pipelineSlug string, opt *PipelineScheduleListOptions) ([]PipelineSchedule, *Response, error)
+	ListAll(ctx context.Context, org string, pipelineSlug string, opt *PipelineScheduleListOptions) iter.Seq2[PipelineSchedule, error]
+	Update(ctx context.Context, org string, pipelineSlug string, id string, in UpdatePipelineSchedule) (PipelineSchedule, *Response, error)
+}
+
+var _ PipelineSchedulesAPI = (*PipelineSchedulesService)(nil)
+
+// PipelineTemplatesAPI is the interface implemented by PipelineTemplatesService.
+type PipelineTemplatesAPI interface {
+	Create(ctx context.Context, org string, ptc PipelineTemplateCreate) (PipelineTemplate, *Response, error)
+	Delete(ctx context.Context, org string, templateUUID string) (*Response, error)
+	Get(ctx context.Context, org string, templateUUID string) (PipelineTemplate, *Response, error)
+	List(ctx context.Context, org string, opt *PipelineTemplateListOptions) ([]PipelineTemplate, *Response, error)
+	ListAll(ctx context.Context, org string, opt *PipelineTemplateListOptions) iter.Seq2[PipelineTemplate, error]
+	Update(ctx context.Context, org string, templateUUID string, ptu PipelineTemplateUpdate) (PipelineTemplate, *Response, error)
+}
+
+var _ PipelineTemplatesAPI = (*PipelineTemplatesService)(nil)
+
+// PipelinesAPI is the interface implemented by PipelinesService.
+type PipelinesAPI interface {
+	AddWebhook(ctx context.Context, org string, slug string) (*Response, error)
+	Archive(ctx context.Context, org string, slug string) (*Response, error)
+	Create(ctx context.Context, org string, p CreatePipeline) (Pipeline, *Response, error)
+	Delete(ctx context.Context, org string, slug string) (*Response, error)
+	Get(ctx context.Context, org string, slug string) (Pipeline, *Response, error)
+	List(ctx context.Context, org string, opt *PipelineListOptions) ([]Pipeline, *Response, error)
+	ListAll(ctx context.Context, org string, opt *PipelineListOptions) iter.Seq2[Pipeline, error]
+	Unarchive(ctx context.Context, org string, slug string) (*Response, error)
+	Update(ctx context.Context, org string, slug string, up UpdatePipeline) (Pipeline, *Response, error)
+}
+
+var _ PipelinesAPI = (*PipelinesService)(nil)
+
+// RateLimitAPI is the interface implemented by RateLimitService.
+type RateLimitAPI interface {
+	Get(ctx context.Context, org string) (RateLimit, *Response, error)
+}
+
+var _ RateLimitAPI = (*RateLimitService)(nil)
+
+// RulesAPI is the interface implemented by RulesService.
+type RulesAPI interface {
+	Create(ctx context.Context, org string, rc RuleCreate) (Rule, *Response, error)
+	Delete(ctx context.Context, org string, ruleUUID string) (*Response, error)
+	Get(ctx context.Context, org string, ruleUUID string) (Rule, *Response, error)
+	List(ctx context.Context, org string, opt *RulesListOptions) ([]Rule, *Response, error)
+	ListAll(ctx context.Context, org string, opt *RulesListOptions) iter.Seq2[Rule, error]
+}
+
+var _ RulesAPI = (*RulesService)(nil)
+
+// StepUploadsAPI is the interface implemented by StepUploadsService.
+type StepUploadsAPI interface {
+	Get(ctx context.Context, org string, pipeline string, buildNumber string, uploadUUID string) (StepUpload, *Response, error)
+	ListByBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *StepUploadsListOptions) (StepUploadsList, *Response, error)
+	ListByBuildAll(ctx context.Context, org string, pipeline string, buildNumber string, opt *StepUploadsListOptions) iter.Seq2[StepUpload, error]
+}
+
+var _ StepUploadsAPI = (*StepUploadsService)(nil)
+
+// TeamMemberAPI is the interface implemented by TeamMemberService.
+type TeamMemberAPI interface {
+	CreateTeamMember(ctx context.Context, org string, teamID string, t CreateTeamMember) (TeamMember, *Response, error)
+	DeleteTeamMember(ctx context.Context, org string, teamID string, userID string) (*Response, error)
+	GetTeamMember(ctx context.Context, org string, teamID string, userID string) (TeamMember, error)
+	ListTeamMembers(ctx context.Context, org string, id string, opt *TeamMembersListOptions) ([]TeamMember, *Response, error)
+	ListTeamMembersAll(ctx context.Context, org string, id string, opt *TeamMembersListOptions) iter.Seq2[TeamMember, error]
+	UpdateTeamMember(ctx context.Context, org string, teamID string, userID string, role string) (TeamMember, *Response, error)
+}
+
+var _ TeamMemberAPI = (*TeamMemberService)(nil)
+
+// TeamPipelinesAPI is the interface implemented by TeamPipelinesService.
+type TeamPipelinesAPI interface {
+	Create(ctx context.Context, org string, teamID string, ctp CreateTeamPipelines) (TeamPipeline, *Response, error)
+	Delete(ctx context.Context, org string, teamID string, pipelineID string) (*Response, error)
+	Get(ctx context.Context, org string, teamID string, pipelineID string) (TeamPipeline, *Response, error)
+	List(ctx context.Context, org string, id string, opt *TeamPipelinesListOptions) ([]TeamPipeline, *Response, error)
+	ListAll(ctx context.Context, org string, id string, opt *TeamPipelinesListOptions) iter.Seq2[TeamPipeline, error]
+	Update(ctx context.Context, org string, teamID string, pipelineID string, utp UpdateTeamPipelines) (TeamPipeline, *Response, error)
+}
+
+var _ TeamPipelinesAPI = (*TeamPipelinesService)(nil)
+
+// TeamSuitesAPI is the interface implemented by TeamSuitesService.
+type TeamSuitesAPI interface {
+	Create(ctx context.Context, org string, teamID string, cts CreateTeamSuites) (TeamSuites, *Response, error)
+	Delete(ctx context.Context, org string, teamID string, suiteID string) (*Response, error)
+	Get(ctx context.Context, org string, teamID string, suiteID string) (TeamSuites, *Response, error)
+	List(ctx context.Context, org string, id string, opt *TeamSuitesListOptions) ([]TeamSuites, *Response, error)
+	ListAll(ctx context.Context, org string, id string, opt *TeamSuitesListOptions) iter.Seq2[TeamSuites, error]
+	Update(ctx context.Context, org string, teamID string, pipelineID string, utp UpdateTeamSuites) (TeamSuites, *Response, error)
+}
+
+var _ TeamSuitesAPI = (*TeamSuitesService)(nil)
+
+// TeamsAPI is the interface implemented by TeamsService.
+type TeamsAPI interface {
+	CreateTeam(ctx context.Context, org string, t CreateTeam) (Team, *Response, error)
+	DeleteTeam(ctx context.Context, org string, id string) (*Response, error)
+	GetTeam(ctx context.Context, org string, id string) (Team, error)
+	List(ctx context.Context, org string, opt *TeamsListOptions) ([]Team, *Response, error)
+	ListAll(ctx context.Context, org string, opt *TeamsListOptions) iter.Seq2[Team, error]
+	UpdateTeam(ctx context.Context, org string, id string, t UpdateTeam) (Team, *Response, error)
+}
+
+var _ TeamsAPI = (*TeamsService)(nil)
+
+// TestRunsAPI is the interface implemented by TestRunsService.
+type TestRunsAPI interface {
+	Get(ctx context.Context, org string, slug string, runID string) (TestRun, *Response, error)
+	GetFailedExecutions(ctx context.Context, org string, slug string, runID string, opt *FailedExecutionsOptions) ([]FailedExecution, *Response, error)
+	List(ctx context.Context, org string, slug string, opt *TestRunsListOptions) ([]TestRun, *Response, error)
+	ListAll(ctx context.Context, org string, slug string, opt *TestRunsListOptions) iter.Seq2[TestRun, error]
+}
+
+var _ TestRunsAPI = (*TestRunsService)(nil)
+
+// TestSuitesAPI is the interface implemented by TestSuitesService.
+type TestSuitesAPI interface {
+	Create(ctx context.Context, org string, ts TestSuiteCreate) (TestSuite, *Response, error)
+	Delete(ctx context.Context, org string, slug string) (*Response, error)
+	Get(ctx context.Context, org string, slug string) (TestSuite, *Response, error)
+	List(ctx context.Context, org string, opt *TestSuiteListOptions) ([]TestSuite, *Response, error)
+	ListAll(ctx context.Context, org string, opt *TestSuiteListOptions) iter.Seq2[TestSuite, error]
+	Update(ctx context.Context, org string, slug string, ts TestSuiteUpdate) (TestSuite, *Response, error)
+}
+
+var _ TestSuitesAPI = (*TestSuitesService)(nil)
+
+// TestsAPI is the interface implemented by TestsService.
+type TestsAPI interface {
+	Find(ctx context.Context, org string, slug string, find FindTestOptions) (Test, *Response, error)
+	Get(ctx context.Context, org string, slug string, testID string) (Test, *Response, error)
+	List(ctx context.Context, org string, slug string, opt *TestsListOptions) ([]TestWithMetrics, *Response, error)
+	ListAll(ctx context.Context, org string, slug string, opt *TestsListOptions) iter.Seq2[TestWithMetrics, error]
+}
+
+var _ TestsAPI = (*TestsService)(nil)
+
+// UserAPI is the interface implemented by UserService.
+type UserAPI interface {
+	CurrentUser(ctx context.Context) (User, *Response, error)
+}
+
+var _ UserAPI = (*UserService)(nil)
+
+// ClientAPI is the interface implemented by Client, giving access to each of
+// its services through their interfaces. See the buildkitefake package.
+type ClientAPI interface {
+	AccessTokensAPI() AccessTokensAPI
+	AgentsAPI() AgentsAPI
+	AnnotationsAPI() AnnotationsAPI
+	ArtifactsAPI() ArtifactsAPI
+	BuildTestsAPI() BuildTestsAPI
+	BuildsAPI() BuildsAPI
+	ClusterMaintainersAPI() ClusterMaintainersAPI
+	ClusterQueuesAPI() ClusterQueuesAPI
+	ClusterSecretsAPI() ClusterSecretsAPI
+	ClusterTokensAPI() ClusterTokensAPI
+	ClustersAPI() ClustersAPI
+	EmojisAPI() EmojisAPI
+	FlakyTestsAPI() FlakyTestsAPI
+	GraphQLAPI() GraphQLAPI
+	JobsAPI() JobsAPI
+	MembersAPI() MembersAPI
+	MetaAPI() MetaAPI
+	OrganizationsAPI() OrganizationsAPI
+	PackageRegistriesAPI() PackageRegistriesAPI
+	PackageRegistryTokensAPI() PackageRegistryTokensAPI
+	PackagesAPI() PackagesAPI
+	PipelineSchedulesAPI() PipelineSchedulesAPI
+	PipelineTemplatesAPI() PipelineTemplatesAPI
+	PipelinesAPI() PipelinesAPI
+	RateLimitAPI() RateLimitAPI
+	RulesAPI() RulesAPI
+	StepUploadsAPI() StepUploadsAPI
+	TeamMemberAPI() TeamMemberAPI
+	TeamPipelinesAPI() TeamPipelinesAPI
+	TeamSuitesAPI() TeamSuitesAPI
+	TeamsAPI() TeamsAPI
+	TestRunsAPI() TestRunsAPI
+	TestSuitesAPI() TestSuitesAPI
+	TestsAPI() TestsAPI
+	UserAPI() UserAPI
+}
+
+var _ ClientAPI = (*Client)(nil)
+
+// AccessTokensAPI returns c.AccessTokens.
+func (c *Client) AccessTokensAPI() AccessTokensAPI {
+	return c.AccessTokens
+}
+
+// AgentsAPI returns c.Agents.
+func (c *Client) AgentsAPI() AgentsAPI {
+	return c.Agents
+}
+
+// AnnotationsAPI returns c.Annotations.
+func (c *Client) AnnotationsAPI() AnnotationsAPI {
+	return c.Annotations
+}
+
+// ArtifactsAPI returns c.Artifacts.
+func (c *Client) ArtifactsAPI() ArtifactsAPI {
+	return c.Artifacts
+}
+
+// BuildTestsAPI returns c.BuildTests.
+func (c *Client) BuildTestsAPI() BuildTestsAPI {
+	return c.BuildTests
+}
+
+// BuildsAPI returns c.Builds.
+func (c *Client) BuildsAPI() BuildsAPI {
+	return c.Builds
+}
+
+// ClusterMaintainersAPI returns c.ClusterMaintainers.
+func (c *Client) ClusterMaintainersAPI() ClusterMaintainersAPI {
+	return c.ClusterMaintainers
+}
+
+// ClusterQueuesAPI returns c.ClusterQueues.
+func (c *Client) ClusterQueuesAPI() ClusterQueuesAPI {
+	return c.ClusterQueues
+}
+
+// ClusterSecretsAPI returns c.ClusterSecrets.
+func (c *Client) ClusterSecretsAPI() ClusterSecretsAPI {
+	return c.ClusterSecrets
+}
+
+// ClusterTokensAPI returns c.ClusterTokens.
+func (c *Client) ClusterTokensAPI() ClusterTokensAPI {
+	return c.ClusterTokens
+}
+
+// ClustersAPI returns c.Clusters.
+func (c *Client) ClustersAPI() ClustersAPI {
+	return c.Clusters
+}
+
+// EmojisAPI returns c.Emojis.
+func (c *Client) EmojisAPI() EmojisAPI {
+	return c.Emojis
+}
+
+// FlakyTestsAPI returns c.FlakyTests.
+func (c *Client) FlakyTestsAPI() FlakyTestsAPI {
+	return c.FlakyTests
+}
+
+// GraphQLAPI returns c.GraphQL.
+func (c *Client) GraphQLAPI() GraphQLAPI {
+	return c.GraphQL
+}
+
+// JobsAPI returns c.Jobs.
+func (c *Client) JobsAPI() JobsAPI {
+	return c.Jobs
+}
+
+// MembersAPI returns c.Members.
+func (c *Client) MembersAPI() MembersAPI {
+	return c.Members
+}
+
+// MetaAPI returns c.Meta.
+func (c *Client) MetaAPI() MetaAPI {
+	return c.Meta
+}
+
+// OrganizationsAPI returns c.Organizations.
+func (c *Client) OrganizationsAPI() OrganizationsAPI {
+	return c.Organizations
+}
+
+// PackageRegistriesAPI returns c.PackageRegistriesService.
+func (c *Client) PackageRegistriesAPI() PackageRegistriesAPI {
+	return c.PackageRegistriesService
+}
+
+// PackageRegistryTokensAPI returns c.PackageRegistryTokensService.
+func (c *Client) PackageRegistryTokensAPI() PackageRegistryTokensAPI {
+	return c.PackageRegistryTokensService
+}
+
+// PackagesAPI returns c.PackagesService.
+func (c *Client) PackagesAPI() PackagesAPI {
+	return c.PackagesService
+}
+
+// PipelineSchedulesAPI returns c.PipelineSchedules.
+func (c *Client) PipelineSchedulesAPI() PipelineSchedulesAPI {
+	return c.PipelineSchedules
+}
+
+// PipelineTemplatesAPI returns c.PipelineTemplates.
+func (c *Client) PipelineTemplatesAPI() PipelineTemplatesAPI {
+	return c.PipelineTemplates
+}
+
+// PipelinesAPI returns c.Pipelines.
+func (c *Client) PipelinesAPI() PipelinesAPI {
+	return c.Pipelines
+}
+
+// RateLimitAPI returns c.RateLimit.
+func (c *Client) RateLimitAPI() RateLimitAPI {
+	return c.RateLimit
+}
+
+// RulesAPI returns c.Rules.
+func (c *Client) RulesAPI() RulesAPI {
+	return c.Rules
+}
+
+// StepUploadsAPI returns c.StepUploads.
+func (c *Client) StepUploadsAPI() StepUploadsAPI {
+	return c.StepUploads
+}
+
+// TeamMemberAPI returns c.TeamMember.
+func (c *Client) TeamMemberAPI() TeamMemberAPI {
+	return c.TeamMember
+}
+
+// TeamPipelinesAPI returns c.TeamPipelines.
+func (c *Client) TeamPipelinesAPI() TeamPipelinesAPI {
+	return c.TeamPipelines
+}
+
+// TeamSuitesAPI returns c.TeamSuites.
+func (c *Client) TeamSuitesAPI() TeamSuitesAPI {
+	return c.TeamSuites
+}
+
+// TeamsAPI returns c.Teams.
+func (c *Client) TeamsAPI() TeamsAPI {
+	return c.Teams
+}
+
+// TestRunsAPI returns c.TestRuns.
+func (c *Client) TestRunsAPI() TestRunsAPI {
+	return c.TestRuns
+}
+
+// TestSuitesAPI returns c.TestSuites.
+func (c *Client) TestSuitesAPI() TestSuitesAPI {
+	return c.TestSuites
+}
+
+// TestsAPI returns c.Tests.
+func (c *Client) TestsAPI() TestsAPI {
+	return c.Tests
+}
+
+// UserAPI returns c.User.
+func (c *Client) UserAPI() UserAPI {
+	return c.User
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 )
 
@@ -17,26 +18,26 @@ type ArtifactsService struct {
 
 // Artifact represents an artifact which has been stored from a build
 type Artifact struct {
-	ID           string `json:"id,omitempty"`
-	JobID        string `json:"job_id,omitempty"`
-	URL          string `json:"url,omitempty"`
-	DownloadURL  string `json:"download_url,omitempty"`
-	State        string `json:"state,omitempty"`
-	Path         string `json:"path,omitempty"`
-	Dirname      string `json:"dirname,omitempty"`
-	Filename     string `json:"filename,omitempty"`
-	MimeType     string `json:"mime_type,omitempty"`
-	FileSize     int64  `json:"file_size,omitempty"`
-	GlobPath     string `json:"glob_path,omitempty"`
-	OriginalPath string `json:"original_path,omitempty"`
-	SHA1         string `json:"sha1sum,omitempty"`
+	ID           string        `json:"id,omitempty"`
+	JobID        string        `json:"job_id,omitempty"`
+	URL          string        `json:"url,omitempty"`
+	DownloadURL  string        `json:"download_url,omitempty"`
+	State        ArtifactState `json:"state,omitempty"`
+	Path         string        `json:"path,omitempty"`
+	Dirname      string        `json:"dirname,omitempty"`
+	Filename     string        `json:"filename,omitempty"`
+	MimeType     string        `json:"mime_type,omitempty"`
+	FileSize     int64         `json:"file_size,omitempty"`
+	GlobPath     string        `json:"glob_path,omitempty"`
+	OriginalPath string        `json:"original_path,omitempty"`
+	SHA1         string        `json:"sha1sum,omitempty"`
 }
 
 // ArtifactListOptions specifies the optional parameters to the
 // ArtifactsService.List method.
 type ArtifactListOptions struct {
-	// Filters the results by the given artifact state (e.g. "new", "finished", "error", "deleted", "expired")
-	State string `url:"state,omitempty"`
+	// Filters the results by the given artifact state, such as ArtifactStateFinished
+	State ArtifactState `url:"state,omitempty"`
 
 	// Filters the results by the given artifact path
 	Path string `url:"path,omitempty"`
@@ -67,6 +68,14 @@ func (as *ArtifactsService) ListByBuild(ctx context.Context, org string, pipelin
 	return artifacts, resp, err
 }
 
+// ListByBuildAll is like ListByBuild but returns an iterator over the results
+// from every page, fetching each page as iteration reaches it.
+func (as *ArtifactsService) ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+		return as.ListByBuild(ctx, org, pipeline, build, opt)
+	})
+}
+
 // ListByJob gets artifacts for a specific build
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/artifacts#list-artifacts-for-a-job
@@ -90,6 +99,14 @@ func (as *ArtifactsService) ListByJob(ctx context.Context, org string, pipeline
 	return artifacts, resp, err
 }
 
+// ListByJobAll is like ListByJob but returns an iterator over the results from
+// every page, fetching each page as iteration reaches it.
+func (as *ArtifactsService) ListByJobAll(ctx context.Context, org string, pipeline string, build string, job string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+		return as.ListByJob(ctx, org, pipeline, build, job, opt)
+	})
+}
+
 func (as *ArtifactsService) Get(ctx context.Context, org, pipeline, build, job, id string) (Artifact, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/pipelines/%s/builds/%s/jobs/%s/artifacts/%s", org, pipeline, build, job, id)
 	req, err := as.client.NewRequest(ctx, "GET", u, nil)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // BuildTestsService handles communication with the build test related
@@ -77,3 +78,11 @@ func (bts *BuildTestsService) List(ctx context.Context, org, buildUUID string, o
 
 	return buildTests, resp, err
 }
+
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (bts *BuildTestsService) ListAll(ctx context.Context, org, buildUUID string, opt *BuildTestsListOptions) iter.Seq2[TestWithMetrics, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *BuildTestsListOptions) ([]TestWithMetrics, *Response, error) {
+		return bts.List(ctx, org, buildUUID, opt)
+	})
+}
@@ -115,3 +115,54 @@ func TestBuildTestsService_List(t *testing.T) {
 		t.Errorf("response Link header = %q, want %q", got, linkHeader)
 	}
 }
+
+func TestBuildTestsService_ListAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	path := fmt.Sprintf("/v2/analytics/organizations/my-great-org/builds/%s/tests", testBuildUUID)
+	server.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			testFormValues(t, r, values{"state": "enabled"})
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.buildkite.com%s?page=2&state=enabled>; rel="next"`, path))
+			_, _ = fmt.Fprint(w, `[{"id":"test-1","name":"is correctly formatted","reliability":0.5,"executions_count":2,"executions_count_by_result":{"passed":1,"failed":1}}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "state": "enabled"})
+			_, _ = fmt.Fprint(w, `[{"id":"test-2","name":"rejects blank emails","reliability":1,"executions_count":3,"executions_count_by_result":{"passed":3}}]`)
+		default:
+			t.Fatalf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	var got []TestWithMetrics
+	for test, err := range client.BuildTests.ListAll(context.Background(), "my-great-org", testBuildUUID, &BuildTestsListOptions{State: "enabled"}) {
+		if err != nil {
+			t.Fatalf("BuildTests.ListAll returned error: %v", err)
+		}
+		got = append(got, test)
+	}
+
+	half, all := 0.5, 1.0
+	want := []TestWithMetrics{
+		{
+			Test:                    Test{ID: "test-1", Name: "is correctly formatted"},
+			Reliability:             &half,
+			ExecutionsCount:         2,
+			ExecutionsCountByResult: map[string]int{"passed": 1, "failed": 1},
+		},
+		{
+			Test:                    Test{ID: "test-2", Name: "rejects blank emails"},
+			Reliability:             &all,
+			ExecutionsCount:         3,
+			ExecutionsCountByResult: map[string]int{"passed": 3},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("BuildTests.ListAll diff: (-got +want)\n%s", diff)
+	}
+}
@@ -1,6 +1,8 @@
 // Package buildkite provides a client for the Buildkite API.
 package buildkite
 
+//go:generate go run ./internal/cmd/genapi
+
 import (
 	"bytes"
 	"context"
@@ -8,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand/v2"
 	"net/http"
 	"net/http/httputil"
@@ -18,12 +21,14 @@ import (
 	"time"
 
 	"github.com/buildkite/go-buildkite/v5/internal/bkmultipart"
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
 	"github.com/buildkite/roko"
 	"github.com/google/go-querystring/query"
 )
 
 const (
 	DefaultBaseURL    = "https://api.buildkite.com/"
+	DefaultGraphQLURL = "https://graphql.buildkite.com/v1"
 	DefaultMaxRetries = 3
 )
 
@@ -43,6 +48,10 @@ type Client struct {
 	// always be specified with a trailing slash.
 	BaseURL *url.URL
 
+	// GraphQL API endpoint used by the GraphQL service. Defaults to the public
+	// buildkite GraphQL API.
+	GraphQLURL *url.URL
+
 	// User agent used when communicating with the buildkite API.
 	UserAgent string
 
@@ -60,6 +69,7 @@ type Client struct {
 	ClusterMaintainers           *ClusterMaintainersService
 	Emojis                       *EmojisService
 	FlakyTests                   *FlakyTestsService
+	GraphQL                      *GraphQLService
 	Jobs                         *JobsService
 	Members                      *MembersService
 	Meta                         *MetaService
@@ -83,9 +93,21 @@ type Client struct {
 	TestSuites                   *TestSuitesService
 
 	authHeader      string
+	tokenSource     TokenSource
+	requiredScopes  []string
 	httpDebug       bool
+	logger          *slog.Logger
+	logBodies       bool
+	middleware      []Middleware
+	cache           CacheStore
+	dryRun          DryRunSink
 	rateLimitNotify RateLimitNotify
+	retryPolicy     RetryPolicy
+	throttle        *throttle
+	breaker         *breaker
 	maxRetries      int
+	maxRetryWait    time.Duration
+	retryNotify     RetryEventNotify
 	sleepFunc       func(time.Duration) // test-only override for retry backoff; nil uses roko's default
 }
 
@@ -126,6 +148,20 @@ func WithBaseURL(baseURL string) ClientOpt {
 	}
 }
 
+// WithGraphQLURL configures the buildkite.Client to send GraphQL requests to the provided URL, instead of the default of
+// https://graphql.buildkite.com/v1
+func WithGraphQLURL(graphQLURL string) ClientOpt {
+	return func(c *Client) error {
+		var err error
+		c.GraphQLURL, err = url.Parse(graphQLURL)
+		if err != nil {
+			return fmt.Errorf("failed to parse graphQLURL: %w", err)
+		}
+
+		return nil
+	}
+}
+
 // WithUserAgent configures the buildkite.Client to use the provided user agent string, instead of the default of "go-buildkite/<version>"
 func WithUserAgent(userAgent string) ClientOpt {
 	return func(c *Client) error {
@@ -137,14 +173,18 @@ func WithUserAgent(userAgent string) ClientOpt {
 // WithTokenAuth configures the buildkite.Client to use the provided token for authentication.
 // This is the recommended way to authenticate with the buildkite API
 // Note that at least one of [WithTokenAuth] or [WithBasicAuth] must be provided to NewOpts
+// To rotate tokens without rebuilding the Client, use [WithTokenSource] instead.
 func WithTokenAuth(token string) ClientOpt {
 	return func(c *Client) error {
 		c.authHeader = fmt.Sprintf("Bearer %s", token)
+		c.tokenSource = nil
 		return nil
 	}
 }
 
-// WithHTTPDebug configures the buildkite.Client to print debug information about HTTP requests and responses as it makes them
+// WithHTTPDebug configures the buildkite.Client to print debug information about HTTP requests and responses as it makes them.
+// The Authorization header is redacted from printed requests, but request and response bodies are printed as-is; use
+// [WithLogger] for structured logging with secret values redacted.
 func WithHTTPDebug(debug bool) ClientOpt {
 	return func(c *Client) error {
 		c.httpDebug = debug
@@ -165,18 +205,81 @@ func WithRateLimitNotify(fn RateLimitNotify) ClientOpt {
 	}
 }
 
-// WithMaxRetries sets the maximum number of retry attempts on rate-limited requests.
+// WithRetryPolicy configures which failures other than rate limiting are
+// retried, such as 5xx responses from a load balancer and transient network
+// errors. See RetryPolicy and DefaultRetryPolicy. Only 5xx status codes may be
+// listed in the policy.
+func WithRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		for _, code := range p.StatusCodes {
+			if code < 500 || code > 599 {
+				return fmt.Errorf("retry policy status codes must be 5xx, got %d", code)
+			}
+		}
+		c.retryPolicy = p
+		return nil
+	}
+}
+
+// WithRateLimitThrottle enables client-side pacing of requests based on the
+// RateLimit-* headers of previous responses. Once the remaining budget drops
+// to threshold or below, each request waits for an equal share of the time
+// left in the rate limit window, and once the budget is spent requests wait
+// for the window to reset. This keeps large batch jobs from hitting 429s
+// rather than relying on retries after the fact.
+//
+// Requests are never delayed before the first response with rate limit
+// headers has been received.
+func WithRateLimitThrottle(threshold int) ClientOpt {
+	return func(c *Client) error {
+		if threshold < 0 {
+			return fmt.Errorf("rate limit throttle threshold must be >= 0, got %d", threshold)
+		}
+		c.throttle = newThrottle(threshold)
+		return nil
+	}
+}
+
+// WithCircuitBreaker enables a client-side circuit breaker, so that while the
+// API is degraded requests fail fast rather than adding to its load. Once a
+// group of requests has failed cb.Threshold times in a row with 5xx responses
+// or timeouts, Do rejects further requests in the group with a
+// *CircuitOpenError, which matches ErrCircuitOpen with errors.Is, until
+// cb.Cooldown has passed. Then a single probe request is let through, which
+// closes the circuit if it succeeds and opens it again if not.
+//
+// Each attempt at a request counts, so a request retried under
+// WithRetryPolicy can open the circuit by itself, and stops retrying once it
+// does.
+func WithCircuitBreaker(cb CircuitBreaker) ClientOpt {
+	return func(c *Client) error {
+		if cb.Threshold < 1 {
+			return fmt.Errorf("circuit breaker threshold must be >= 1, got %d", cb.Threshold)
+		}
+		if cb.Cooldown < 0 {
+			return fmt.Errorf("circuit breaker cooldown must be >= 0, got %v", cb.Cooldown)
+		}
+		c.breaker = newBreaker(cb)
+		return nil
+	}
+}
+
+// WithMaxRetries sets the maximum number of retry attempts on rate-limited requests,
+// and on any failures covered by WithRetryPolicy.
 // Defaults to DefaultMaxRetries (3). Use 0 to disable retries entirely.
 //
-// There is no internal wall-clock time limit. With a high retry count and a
-// server consistently returning RateLimit-Reset: 120, Do() can block for many
-// minutes. Callers should set a context deadline to bound total wait time.
+// By default there is no limit on the total time spent waiting between
+// retries. With a high retry count and a server consistently returning
+// RateLimit-Reset: 120, Do() can block for many minutes. Use WithMaxRetryWait
+// or a context deadline to bound total wait time.
 //
-// All HTTP methods are retried, including POST, PUT, and DELETE, provided the
-// request body is rewindable (i.e. created via NewRequest with a struct or
+// All HTTP methods are retried on 429, including POST, PUT, and DELETE, provided
+// the request body is rewindable (i.e. created via NewRequest with a struct or
 // bytes.Buffer body). Callers that cannot tolerate duplicate side-effects on
 // non-idempotent requests should pass a context with an appropriate deadline
-// or set WithMaxRetries(0) for those specific calls.
+// or set WithMaxRetries(0) for those specific calls. Failures covered by a
+// RetryPolicy are only retried for non-idempotent requests when
+// RetryPolicy.NonIdempotent is set.
 func WithMaxRetries(n int) ClientOpt {
 	return func(c *Client) error {
 		if n < 0 {
@@ -187,15 +290,45 @@ func WithMaxRetries(n int) ClientOpt {
 	}
 }
 
+// WithMaxRetryWait bounds the total back-off Do may wait between the attempts
+// of a request. Once the delay before the next retry would take the total past
+// total, Do stops retrying and returns a *RetryBudgetError wrapping the last
+// failure, which matches ErrRetryBudgetExceeded with errors.Is. Defaults to 0,
+// which sets no bound.
+func WithMaxRetryWait(total time.Duration) ClientOpt {
+	return func(c *Client) error {
+		if total < 0 {
+			return fmt.Errorf("max retry wait must be >= 0, got %v", total)
+		}
+		c.maxRetryWait = total
+		return nil
+	}
+}
+
+// WithRetryEventNotify registers a callback invoked on every failed attempt
+// that Do may retry, whether after a 429, a failure covered by WithRetryPolicy,
+// or on the final attempt where no retry follows. Unlike RateLimitNotify, the
+// RetryEvent identifies the request and the response that caused the retry, so
+// rate limiting can be attributed to specific endpoints. Passing nil clears
+// any previously registered callback.
+func WithRetryEventNotify(fn RetryEventNotify) ClientOpt {
+	return func(c *Client) error {
+		c.retryNotify = fn
+		return nil
+	}
+}
+
 // NewClient returns a new buildkite API client with the provided options.
 // Note that at [WithTokenAuth] must be provided for requests to the buildkite API to succeed.
 // Otherwise, sensible defaults are used.
 func NewClient(opts ...ClientOpt) (*Client, error) {
 	baseURL, _ := url.Parse(DefaultBaseURL)
+	graphQLURL, _ := url.Parse(DefaultGraphQLURL)
 
 	c := &Client{
 		client:     http.DefaultClient,
 		BaseURL:    baseURL,
+		GraphQLURL: graphQLURL,
 		UserAgent:  DefaultUserAgent,
 		maxRetries: DefaultMaxRetries,
 	}
@@ -209,6 +342,12 @@ func NewClient(opts ...ClientOpt) (*Client, error) {
 
 	c.populateDefaultServices()
 
+	if len(c.requiredScopes) > 0 {
+		if err := c.CheckScopes(context.Background(), c.requiredScopes...); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
@@ -231,6 +370,7 @@ func (c *Client) populateDefaultServices() {
 	c.ClusterMaintainers = &ClusterMaintainersService{c}
 	c.Emojis = &EmojisService{c}
 	c.FlakyTests = &FlakyTestsService{c}
+	c.GraphQL = &GraphQLService{c}
 	c.Jobs = &JobsService{c}
 	c.Members = &MembersService{c}
 	c.Meta = &MetaService{c}
@@ -343,6 +483,9 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body any
 	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
+	if opts := requestOptionsFrom(ctx); opts != nil {
+		opts.apply(req)
+	}
 
 	return req, nil
 }
@@ -362,12 +505,22 @@ type Response struct {
 	PrevPage  int
 	FirstPage int
 	LastPage  int
+
+	// Rate is the rate limit budget reported by the response's RateLimit-*
+	// headers. Its Limit is 0 when the response did not include them.
+	Rate Rate
+
+	// DryRun is set when the request was recorded rather than sent, because
+	// the client was created with WithDryRun, and the response is synthetic.
+	DryRun bool
 }
 
 // newResponse creats a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
+	response.Rate, _ = parseRate(r.Header, time.Now())
+	response.DryRun = r.Header.Get(dryRunHeader) != ""
 	return response
 }
 
@@ -431,6 +584,12 @@ func retryDelay(resp *http.Response, attempt int) time.Duration {
 			return time.Duration(secs)*time.Second + 500*time.Millisecond + time.Duration(rand.N(time.Second))
 		}
 	}
+	return backoffDelay(attempt)
+}
+
+// backoffDelay returns a capped exponential backoff for the given 0-based
+// attempt, with up to 1 second of random jitter.
+func backoffDelay(attempt int) time.Duration {
 	// Cap the shift to prevent int64 overflow at high attempt counts.
 	shift := attempt
 	if shift > 5 {
@@ -452,11 +611,19 @@ func retryDelay(resp *http.Response, attempt int) time.Duration {
 func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	var resp *http.Response
 
+	opts := requestOptionsFrom(req.Context())
+	maxRetries := opts.maxRetriesOr(c.maxRetries)
+	if opts != nil && opts.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), opts.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
 	// roko requires a strategy, but the real delay is always driven by the
 	// server response (RateLimit-Reset header or exponential fallback), so
 	// Constant(0) is a required placeholder that is always overridden.
 	retrierOpts := []roko.RetrierOpt{
-		roko.WithMaxAttempts(c.maxRetries + 1),
+		roko.WithMaxAttempts(maxRetries + 1),
 		roko.WithStrategy(roko.Constant(0)),
 	}
 	if c.sleepFunc != nil {
@@ -464,6 +631,29 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	}
 	retrier := roko.NewRetrier(retrierOpts...)
 
+	doer := c.doer()
+
+	budget := &retryBudget{limit: c.maxRetryWait}
+
+	var circuitGroup string
+	if c.breaker != nil {
+		circuitGroup = c.breaker.group(req)
+	}
+
+	// schedule sets the delay before the next attempt, unless no retries remain
+	// or the delay would overrun the budget, and reports whether one follows.
+	schedule := func(rt *roko.Retrier, delay time.Duration) (time.Duration, bool) {
+		if rt.AttemptCount() >= maxRetries {
+			return 0, false
+		}
+		if !budget.take(delay) {
+			rt.Break()
+			return 0, false
+		}
+		rt.SetNextInterval(delay)
+		return delay, true
+	}
+
 	rokoErr := retrier.DoWithContext(req.Context(), func(rt *roko.Retrier) error {
 		// GetBody is set automatically by http.NewRequestWithContext for
 		// bytes.Buffer/bytes.Reader bodies, enabling body replay on retry.
@@ -477,44 +667,127 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 		}
 
 		if c.httpDebug {
+			auth := req.Header.Get("Authorization")
+			if auth != "" {
+				req.Header.Set("Authorization", redact.Redacted)
+			}
 			if dump, err := httputil.DumpRequest(req, true); err == nil {
 				fmt.Printf("DEBUG request uri=%s\n%s\n", req.URL, dump)
 			}
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
 		}
 
+		// canRewind is false for raw io.Reader bodies where GetBody is not set;
+		// those requests are not retried since the body cannot be replayed.
+		// When canRewind is false and the response is 429, returning nil causes
+		// roko to treat the call as successful and exit the loop; execution then
+		// falls through to checkResponse which surfaces a proper *ErrorResponse.
+		// The caller receives a 429 *ErrorResponse with no WithRateLimitNotify
+		// signal and no indication that the retry budget was unused.
+		canRewind := req.Body == nil || req.GetBody != nil
+
+		if c.throttle != nil {
+			if err := c.throttle.wait(req.Context()); err != nil {
+				rt.Break()
+				return err
+			}
+		}
+
+		if c.breaker != nil {
+			if err := c.breaker.allow(circuitGroup); err != nil {
+				rt.Break()
+				return err
+			}
+		}
+
+		if c.logger != nil {
+			c.logRequest(req, rt.AttemptCount()+1)
+		}
+		start := time.Now()
+
 		var err error
-		resp, err = c.client.Do(req)
+		resp, err = doer.Do(req)
+		if c.breaker != nil {
+			c.breaker.done(circuitGroup, req, resp, err)
+		}
 		if err != nil {
+			if c.logger != nil {
+				c.logError(req, err, rt.AttemptCount()+1, time.Since(start))
+			}
 			resp = nil
-			rt.Break()
+			if !canRewind || !c.retryPolicy.retriesError(req, err) {
+				rt.Break()
+				return err
+			}
+
+			delay, retrying := schedule(rt, backoffDelay(rt.AttemptCount()))
+			if c.retryPolicy.Notify != nil {
+				c.retryPolicy.Notify(rt.AttemptCount()+1, delay, 0, err)
+			}
+			c.notifyRetry(newRetryEvent(req, nil, err, rt.AttemptCount()+1, delay, retrying, budget))
+			if c.httpDebug {
+				fmt.Printf("DEBUG request failed with %v, retry %d in %v\n", err, rt.AttemptCount()+1, delay)
+			}
+
 			return err
 		}
 
+		// Responses made up by middleware may leave these unset.
+		if resp.Request == nil {
+			resp.Request = req
+		}
+		if resp.Body == nil {
+			resp.Body = http.NoBody
+		}
+
+		if c.logger != nil {
+			c.logResponse(req, resp, rt.AttemptCount()+1, time.Since(start))
+		}
+
+		if c.throttle != nil {
+			if rate, ok := parseRate(resp.Header, time.Now()); ok {
+				c.throttle.update(rate)
+			}
+		}
+
 		if c.httpDebug {
 			if dump, err := httputil.DumpResponse(resp, true); err == nil {
 				fmt.Printf("DEBUG response uri=%s\n%s\n", req.URL, dump)
 			}
 		}
 
-		// canRewind is false for raw io.Reader bodies where GetBody is not set;
-		// those requests are not retried since the body cannot be replayed.
-		// When canRewind is false and the response is 429, returning nil causes
-		// roko to treat the call as successful and exit the loop; execution then
-		// falls through to checkResponse which surfaces a proper *ErrorResponse.
-		// The caller receives a 429 *ErrorResponse with no WithRateLimitNotify
-		// signal and no indication that the retry budget was unused.
-		canRewind := req.Body == nil || req.GetBody != nil
+		if canRewind && c.retryPolicy.retriesStatus(req, resp.StatusCode) {
+			statusCode := resp.StatusCode
+
+			delay, retrying := schedule(rt, serverErrorRetryDelay(resp, rt.AttemptCount()))
+			c.notifyRetry(newRetryEvent(req, resp, nil, rt.AttemptCount()+1, delay, retrying, budget))
+			if retrying {
+				_, _ = io.Copy(io.Discard, resp.Body)
+				_ = resp.Body.Close()
+				resp = nil
+			}
+			if c.retryPolicy.Notify != nil {
+				c.retryPolicy.Notify(rt.AttemptCount()+1, delay, statusCode, nil)
+			}
+			if c.httpDebug {
+				fmt.Printf("DEBUG server error %d, retry %d in %v\n", statusCode, rt.AttemptCount()+1, delay)
+			}
+
+			return errServerError
+		}
+
 		if resp.StatusCode != http.StatusTooManyRequests || !canRewind {
 			return nil
 		}
 
 		// roko calls MarkAttempt() after this callback returns, so AttemptCount()
 		// here is still the 0-based index of the current attempt. The last allowed
-		// attempt is index c.maxRetries (= WithMaxAttempts(c.maxRetries+1) - 1).
-		var delay time.Duration
-		if rt.AttemptCount() < c.maxRetries {
-			delay = retryDelay(resp, rt.AttemptCount())
-			rt.SetNextInterval(delay)
+		// attempt is index maxRetries (= WithMaxAttempts(maxRetries+1) - 1).
+		delay, retrying := schedule(rt, retryDelay(resp, rt.AttemptCount()))
+		c.notifyRetry(newRetryEvent(req, resp, nil, rt.AttemptCount()+1, delay, retrying, budget))
+		if retrying {
 			// More retries remaining — drain and close so the connection can be reused.
 			_, _ = io.Copy(io.Discard, resp.Body)
 			_ = resp.Body.Close()
@@ -533,7 +806,7 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	})
 
 	if resp == nil {
-		return nil, rokoErr
+		return nil, budget.wrap(rokoErr)
 	}
 
 	defer func() {
@@ -546,7 +819,7 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	if err := checkResponse(resp); err != nil {
 		// even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
-		return response, err
+		return response, budget.wrap(err)
 	}
 
 	var err error
@@ -562,17 +835,24 @@ func (c *Client) Do(req *http.Request, v any) (*Response, error) {
 	return response, err
 }
 
-// ErrorResponse provides a message.
+// ErrorResponse provides a message, and any field-level errors reported by
+// the API. Use errors.Is with the sentinel errors such as ErrNotFound to check
+// the kind of failure.
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
-	Message  string         `json:"message"` // error message
-	RawBody  []byte         `json:"-"`       // Raw Response Body
+	Message  string         `json:"message"`          // error message
+	Errors   []FieldError   `json:"errors,omitempty"` // field-level errors, e.g. for 422 validation failures
+	RawBody  []byte         `json:"-"`                // Raw Response Body
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v",
+	msg := fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, r.Response.Request.URL,
 		r.Response.StatusCode, r.Message)
+	if len(r.Errors) > 0 {
+		msg += " (" + fieldErrorsString(r.Errors) + ")"
+	}
+	return msg
 }
 
 func checkResponse(r *http.Response) error {
@@ -0,0 +1,32 @@
+// Package buildkitefake provides fakes of the buildkite package's service
+// interfaces, for unit testing code that calls the Buildkite API without
+// running a server.
+//
+// Write code against buildkite.ClientAPI, or the interface of the one service
+// it uses, such as buildkite.BuildsAPI, and pass it a *buildkite.Client in
+// production and a fake in tests. Each fake method calls the function in the
+// field named after it, and returns ErrNotImplemented if that is nil:
+//
+//	client := buildkitefake.NewClient()
+//	client.Builds.GetFunc = func(ctx context.Context, org, pipeline, number string, opt *buildkite.BuildGetOptions) (buildkite.Build, *buildkite.Response, error) {
+//		return buildkite.Build{Number: 42, State: "passed"}, nil, nil
+//	}
+//
+//	err := deploy(ctx, client) // deploy takes a buildkite.ClientAPI
+//
+// To exercise HTTP behaviour such as pagination, retries and error
+// responses, use the in-memory server in the buildkitetest package instead.
+package buildkitefake
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotImplemented is returned, wrapped, by a fake method whose function
+// field is nil.
+var ErrNotImplemented = errors.New("buildkitefake: not implemented")
+
+func notImplemented(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotImplemented, method)
+}
@@ -0,0 +1,64 @@
+package buildkitefake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/buildkite/go-buildkite/v5"
+	"github.com/buildkite/go-buildkite/v5/buildkitefake"
+)
+
+// latestState is the kind of code under test: it only needs a ClientAPI.
+func latestState(ctx context.Context, api buildkite.ClientAPI, org, pipeline string) (string, error) {
+	builds, _, err := api.BuildsAPI().ListByPipeline(ctx, org, pipeline, &buildkite.BuildsListOptions{
+		ListOptions: buildkite.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(builds) == 0 {
+		return "", nil
+	}
+	return string(builds[0].State), nil
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	client := buildkitefake.NewClient()
+	client.Builds.ListByPipelineFunc = func(ctx context.Context, org, pipeline string, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error) {
+		if org != "acme" || pipeline != "web" {
+			t.Errorf("ListByPipeline(%q, %q), want acme, web", org, pipeline)
+		}
+		return []buildkite.Build{{Number: 2, State: "failed"}}, nil, nil
+	}
+
+	state, err := latestState(context.Background(), client, "acme", "web")
+	if err != nil {
+		t.Fatalf("latestState: %v", err)
+	}
+	if state != "failed" {
+		t.Errorf("latestState = %q, want failed", state)
+	}
+
+	// The real client satisfies the same interface.
+	var _ buildkite.ClientAPI = &buildkite.Client{}
+}
+
+func TestNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	client := buildkitefake.NewClient()
+
+	_, _, err := client.Pipelines.Get(context.Background(), "acme", "web")
+	if !errors.Is(err, buildkitefake.ErrNotImplemented) {
+		t.Errorf("Pipelines.Get error = %v, want ErrNotImplemented", err)
+	}
+
+	for _, err := range client.Agents.ListAll(context.Background(), "acme", nil) {
+		if !errors.Is(err, buildkitefake.ErrNotImplemented) {
+			t.Errorf("Agents.ListAll error = %v, want ErrNotImplemented", err)
+		}
+	}
+}
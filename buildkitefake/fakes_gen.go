@@ -0,0 +1,2473 @@
+// Code generated by internal/cmd/genapi; DO NOT EDIT.
+
+package buildkitefake
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+// AccessTokens is a fake buildkite.AccessTokensAPI.
+type AccessTokens struct {
+	GetFunc    func(ctx context.Context) (buildkite.AccessToken, *buildkite.Response, error)
+	RevokeFunc func(ctx context.Context) (*buildkite.Response, error)
+}
+
+var _ buildkite.AccessTokensAPI = (*AccessTokens)(nil)
+
+// Get calls GetFunc.
+func (f *AccessTokens) Get(ctx context.Context) (buildkite.AccessToken, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.AccessToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("AccessTokens.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// Revoke calls RevokeFunc.
+func (f *AccessTokens) Revoke(ctx context.Context) (*buildkite.Response, error) {
+	if f.RevokeFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("AccessTokens.Revoke")
+	}
+	return f.RevokeFunc(ctx)
+}
+
+// Agents is a fake buildkite.AgentsAPI.
+type Agents struct {
+	CreateFunc  func(ctx context.Context, org string, agent buildkite.Agent) (buildkite.Agent, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, id string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, id string) (buildkite.Agent, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.AgentListOptions) ([]buildkite.Agent, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.AgentListOptions) iter.Seq2[buildkite.Agent, error]
+	PauseFunc   func(ctx context.Context, org string, id string, opts *buildkite.AgentPauseOptions) (*buildkite.Response, error)
+	ResumeFunc  func(ctx context.Context, org string, id string) (*buildkite.Response, error)
+	StopFunc    func(ctx context.Context, org string, id string, force bool) (*buildkite.Response, error)
+}
+
+var _ buildkite.AgentsAPI = (*Agents)(nil)
+
+// Create calls CreateFunc.
+func (f *Agents) Create(ctx context.Context, org string, agent buildkite.Agent) (buildkite.Agent, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Agent
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Agents.Create")
+	}
+	return f.CreateFunc(ctx, org, agent)
+}
+
+// Delete calls DeleteFunc.
+func (f *Agents) Delete(ctx context.Context, org string, id string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Agents.Delete")
+	}
+	return f.DeleteFunc(ctx, org, id)
+}
+
+// Get calls GetFunc.
+func (f *Agents) Get(ctx context.Context, org string, id string) (buildkite.Agent, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Agent
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Agents.Get")
+	}
+	return f.GetFunc(ctx, org, id)
+}
+
+// List calls ListFunc.
+func (f *Agents) List(ctx context.Context, org string, opt *buildkite.AgentListOptions) ([]buildkite.Agent, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Agent
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Agents.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Agents) ListAll(ctx context.Context, org string, opt *buildkite.AgentListOptions) iter.Seq2[buildkite.Agent, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Agent, error) bool) {
+			var zero buildkite.Agent
+			yield(zero, notImplemented("Agents.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Pause calls PauseFunc.
+func (f *Agents) Pause(ctx context.Context, org string, id string, opts *buildkite.AgentPauseOptions) (*buildkite.Response, error) {
+	if f.PauseFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Agents.Pause")
+	}
+	return f.PauseFunc(ctx, org, id, opts)
+}
+
+// Resume calls ResumeFunc.
+func (f *Agents) Resume(ctx context.Context, org string, id string) (*buildkite.Response, error) {
+	if f.ResumeFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Agents.Resume")
+	}
+	return f.ResumeFunc(ctx, org, id)
+}
+
+// Stop calls StopFunc.
+func (f *Agents) Stop(ctx context.Context, org string, id string, force bool) (*buildkite.Response, error) {
+	if f.StopFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Agents.Stop")
+	}
+	return f.StopFunc(ctx, org, id, force)
+}
+
+// Annotations is a fake buildkite.AnnotationsAPI.
+type Annotations struct {
+	CreateFunc         func(ctx context.Context, org string, pipeline string, build string, ac buildkite.AnnotationCreate) (buildkite.Annotation, *buildkite.Response, error)
+	CreateForJobFunc   func(ctx context.Context, org string, pipeline string, build string, jobID string, ac buildkite.AnnotationCreate) (buildkite.Annotation, *buildkite.Response, error)
+	DeleteFunc         func(ctx context.Context, org string, pipeline string, build string, annotationUUID string) (*buildkite.Response, error)
+	DeleteForJobFunc   func(ctx context.Context, org string, pipeline string, build string, jobID string, annotationUUID string) (*buildkite.Response, error)
+	ListByBuildFunc    func(ctx context.Context, org string, pipeline string, build string, opt *buildkite.AnnotationListOptions) ([]buildkite.Annotation, *buildkite.Response, error)
+	ListByBuildAllFunc func(ctx context.Context, org string, pipeline string, build string, opt *buildkite.AnnotationListOptions) iter.Seq2[buildkite.Annotation, error]
+	ListByJobFunc      func(ctx context.Context, org string, pipeline string, build string, jobID string, opt *buildkite.AnnotationListOptions) ([]buildkite.Annotation, *buildkite.Response, error)
+	ListByJobAllFunc   func(ctx context.Context, org string, pipeline string, build string, jobID string, opt *buildkite.AnnotationListOptions) iter.Seq2[buildkite.Annotation, error]
+}
+
+var _ buildkite.AnnotationsAPI = (*Annotations)(nil)
+
+// Create calls CreateFunc.
+func (f *Annotations) Create(ctx context.Context, org string, pipeline string, build string, ac buildkite.AnnotationCreate) (buildkite.Annotation, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Annotation
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Annotations.Create")
+	}
+	return f.CreateFunc(ctx, org, pipeline, build, ac)
+}
+
+// CreateForJob calls CreateForJobFunc.
+func (f *Annotations) CreateForJob(ctx context.Context, org string, pipeline string, build string, jobID string, ac buildkite.AnnotationCreate) (buildkite.Annotation, *buildkite.Response, error) {
+	if f.CreateForJobFunc == nil {
+		var r0 buildkite.Annotation
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Annotations.CreateForJob")
+	}
+	return f.CreateForJobFunc(ctx, org, pipeline, build, jobID, ac)
+}
+
+// Delete calls DeleteFunc.
+func (f *Annotations) Delete(ctx context.Context, org string, pipeline string, build string, annotationUUID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Annotations.Delete")
+	}
+	return f.DeleteFunc(ctx, org, pipeline, build, annotationUUID)
+}
+
+// DeleteForJob calls DeleteForJobFunc.
+func (f *Annotations) DeleteForJob(ctx context.Context, org string, pipeline string, build string, jobID string, annotationUUID string) (*buildkite.Response, error) {
+	if f.DeleteForJobFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Annotations.DeleteForJob")
+	}
+	return f.DeleteForJobFunc(ctx, org, pipeline, build, jobID, annotationUUID)
+}
+
+// ListByBuild calls ListByBuildFunc.
+func (f *Annotations) ListByBuild(ctx context.Context, org string, pipeline string, build string, opt *buildkite.AnnotationListOptions) ([]buildkite.Annotation, *buildkite.Response, error) {
+	if f.ListByBuildFunc == nil {
+		var r0 []buildkite.Annotation
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Annotations.ListByBuild")
+	}
+	return f.ListByBuildFunc(ctx, org, pipeline, build, opt)
+}
+
+// ListByBuildAll calls ListByBuildAllFunc.
+func (f *Annotations) ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *buildkite.AnnotationListOptions) iter.Seq2[buildkite.Annotation, error] {
+	if f.ListByBuildAllFunc == nil {
+		return func(yield func(buildkite.Annotation, error) bool) {
+			var zero buildkite.Annotation
+			yield(zero, notImplemented("Annotations.ListByBuildAll"))
+		}
+	}
+	return f.ListByBuildAllFunc(ctx, org, pipeline, build, opt)
+}
+
+// ListByJob calls ListByJobFunc.
+func (f *Annotations) ListByJob(ctx context.Context, org string, pipeline string, build string, jobID string, opt *buildkite.AnnotationListOptions) ([]buildkite.Annotation, *buildkite.Response, error) {
+	if f.ListByJobFunc == nil {
+		var r0 []buildkite.Annotation
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Annotations.ListByJob")
+	}
+	return f.ListByJobFunc(ctx, org, pipeline, build, jobID, opt)
+}
+
+// ListByJobAll calls ListByJobAllFunc.
+func (f *Annotations) ListByJobAll(ctx context.Context, org string, pipeline string, build string, jobID string, opt *buildkite.AnnotationListOptions) iter.Seq2[buildkite.Annotation, error] {
+	if f.ListByJobAllFunc == nil {
+		return func(yield func(buildkite.Annotation, error) bool) {
+			var zero buildkite.Annotation
+			yield(zero, notImplemented("Annotations.ListByJobAll"))
+		}
+	}
+	return f.ListByJobAllFunc(ctx, org, pipeline, build, jobID, opt)
+}
+
+// Artifacts is a fake buildkite.ArtifactsAPI.
+type Artifacts struct {
+	DeleteFunc                func(ctx context.Context, org string, pipeline string, build string, job string, id string) (*buildkite.Response, error)
+	DownloadArtifactByURLFunc func(ctx context.Context, url string, w io.Writer) (*buildkite.Response, error)
+	GetFunc                   func(ctx context.Context, org string, pipeline string, build string, job string, id string) (buildkite.Artifact, *buildkite.Response, error)
+	ListByBuildFunc           func(ctx context.Context, org string, pipeline string, build string, opt *buildkite.ArtifactListOptions) ([]buildkite.Artifact, *buildkite.Response, error)
+	ListByBuildAllFunc        func(ctx context.Context, org string, pipeline string, build string, opt *buildkite.ArtifactListOptions) iter.Seq2[buildkite.Artifact, error]
+	ListByJobFunc             func(ctx context.Context, org string, pipeline string, build string, job string, opt *buildkite.ArtifactListOptions) ([]buildkite.Artifact, *buildkite.Response, error)
+	ListByJobAllFunc          func(ctx context.Context, org string, pipeline string, build string, job string, opt *buildkite.ArtifactListOptions) iter.Seq2[buildkite.Artifact, error]
+}
+
+var _ buildkite.ArtifactsAPI = (*Artifacts)(nil)
+
+// Delete calls DeleteFunc.
+func (f *Artifacts) Delete(ctx context.Context, org string, pipeline string, build string, job string, id string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Artifacts.Delete")
+	}
+	return f.DeleteFunc(ctx, org, pipeline, build, job, id)
+}
+
+// DownloadArtifactByURL calls DownloadArtifactByURLFunc.
+func (f *Artifacts) DownloadArtifactByURL(ctx context.Context, url string, w io.Writer) (*buildkite.Response, error) {
+	if f.DownloadArtifactByURLFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Artifacts.DownloadArtifactByURL")
+	}
+	return f.DownloadArtifactByURLFunc(ctx, url, w)
+}
+
+// Get calls GetFunc.
+func (f *Artifacts) Get(ctx context.Context, org string, pipeline string, build string, job string, id string) (buildkite.Artifact, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Artifact
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Artifacts.Get")
+	}
+	return f.GetFunc(ctx, org, pipeline, build, job, id)
+}
+
+// ListByBuild calls ListByBuildFunc.
+func (f *Artifacts) ListByBuild(ctx context.Context, org string, pipeline string, build string, opt *buildkite.ArtifactListOptions) ([]buildkite.Artifact, *buildkite.Response, error) {
+	if f.ListByBuildFunc == nil {
+		var r0 []buildkite.Artifact
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Artifacts.ListByBuild")
+	}
+	return f.ListByBuildFunc(ctx, org, pipeline, build, opt)
+}
+
+// ListByBuildAll calls ListByBuildAllFunc.
+func (f *Artifacts) ListByBuildAll(ctx context.Context, org string, pipeline string, build string, opt *buildkite.ArtifactListOptions) iter.Seq2[buildkite.Artifact, error] {
+	if f.ListByBuildAllFunc == nil {
+		return func(yield func(buildkite.Artifact, error) bool) {
+			var zero buildkite.Artifact
+			yield(zero, notImplemented("Artifacts.ListByBuildAll"))
+		}
+	}
+	return f.ListByBuildAllFunc(ctx, org, pipeline, build, opt)
+}
+
+// ListByJob calls ListByJobFunc.
+func (f *Artifacts) ListByJob(ctx context.Context, org string, pipeline string, build string, job string, opt *buildkite.ArtifactListOptions) ([]buildkite.Artifact, *buildkite.Response, error) {
+	if f.ListByJobFunc == nil {
+		var r0 []buildkite.Artifact
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Artifacts.ListByJob")
+	}
+	return f.ListByJobFunc(ctx, org, pipeline, build, job, opt)
+}
+
+// ListByJobAll calls ListByJobAllFunc.
+func (f *Artifacts) ListByJobAll(ctx context.Context, org string, pipeline string, build string, job string, opt *buildkite.ArtifactListOptions) iter.Seq2[buildkite.Artifact, error] {
+	if f.ListByJobAllFunc == nil {
+		return func(yield func(buildkite.Artifact, error) bool) {
+			var zero buildkite.Artifact
+			yield(zero, notImplemented("Artifacts.ListByJobAll"))
+		}
+	}
+	return f.ListByJobAllFunc(ctx, org, pipeline, build, job, opt)
+}
+
+// BuildTests is a fake buildkite.BuildTestsAPI.
+type BuildTests struct {
+	ListFunc    func(ctx context.Context, org string, buildUUID string, opt *buildkite.BuildTestsListOptions) ([]buildkite.TestWithMetrics, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, buildUUID string, opt *buildkite.BuildTestsListOptions) iter.Seq2[buildkite.TestWithMetrics, error]
+}
+
+var _ buildkite.BuildTestsAPI = (*BuildTests)(nil)
+
+// List calls ListFunc.
+func (f *BuildTests) List(ctx context.Context, org string, buildUUID string, opt *buildkite.BuildTestsListOptions) ([]buildkite.TestWithMetrics, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TestWithMetrics
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("BuildTests.List")
+	}
+	return f.ListFunc(ctx, org, buildUUID, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *BuildTests) ListAll(ctx context.Context, org string, buildUUID string, opt *buildkite.BuildTestsListOptions) iter.Seq2[buildkite.TestWithMetrics, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TestWithMetrics, error) bool) {
+			var zero buildkite.TestWithMetrics
+			yield(zero, notImplemented("BuildTests.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, buildUUID, opt)
+}
+
+// Builds is a fake buildkite.BuildsAPI.
+type Builds struct {
+	BulkCancelFunc        func(ctx context.Context, org string, pipeline string, opt *buildkite.BulkOptions) (buildkite.BulkReport, error)
+	BulkRebuildFunc       func(ctx context.Context, org string, pipeline string, opt *buildkite.BulkOptions) (buildkite.BulkReport, error)
+	CancelFunc            func(ctx context.Context, org string, pipeline string, buildNumber string) (buildkite.Build, error)
+	CreateFunc            func(ctx context.Context, org string, pipeline string, b buildkite.CreateBuild) (buildkite.Build, *buildkite.Response, error)
+	CreateAndWaitFunc     func(ctx context.Context, org string, pipeline string, b buildkite.CreateBuild, opt *buildkite.WaitForBuildOptions) (buildkite.Build, buildkite.BuildOutcome, error)
+	GetFunc               func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.BuildGetOptions) (buildkite.Build, *buildkite.Response, error)
+	ListFunc              func(ctx context.Context, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error)
+	ListAllFunc           func(ctx context.Context, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error]
+	ListByOrgFunc         func(ctx context.Context, org string, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error)
+	ListByOrgAllFunc      func(ctx context.Context, org string, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error]
+	ListByPipelineFunc    func(ctx context.Context, org string, pipeline string, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error)
+	ListByPipelineAllFunc func(ctx context.Context, org string, pipeline string, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error]
+	RebuildFunc           func(ctx context.Context, org string, pipeline string, buildNumber string) (buildkite.Build, error)
+	TreeFunc              func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.BuildTreeOptions) (*buildkite.BuildTree, error)
+	WaitForBuildFunc      func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.WaitForBuildOptions) (buildkite.Build, buildkite.BuildOutcome, error)
+}
+
+var _ buildkite.BuildsAPI = (*Builds)(nil)
+
+// BulkCancel calls BulkCancelFunc.
+func (f *Builds) BulkCancel(ctx context.Context, org string, pipeline string, opt *buildkite.BulkOptions) (buildkite.BulkReport, error) {
+	if f.BulkCancelFunc == nil {
+		var r0 buildkite.BulkReport
+		return r0, notImplemented("Builds.BulkCancel")
+	}
+	return f.BulkCancelFunc(ctx, org, pipeline, opt)
+}
+
+// BulkRebuild calls BulkRebuildFunc.
+func (f *Builds) BulkRebuild(ctx context.Context, org string, pipeline string, opt *buildkite.BulkOptions) (buildkite.BulkReport, error) {
+	if f.BulkRebuildFunc == nil {
+		var r0 buildkite.BulkReport
+		return r0, notImplemented("Builds.BulkRebuild")
+	}
+	return f.BulkRebuildFunc(ctx, org, pipeline, opt)
+}
+
+// Cancel calls CancelFunc.
+func (f *Builds) Cancel(ctx context.Context, org string, pipeline string, buildNumber string) (buildkite.Build, error) {
+	if f.CancelFunc == nil {
+		var r0 buildkite.Build
+		return r0, notImplemented("Builds.Cancel")
+	}
+	return f.CancelFunc(ctx, org, pipeline, buildNumber)
+}
+
+// Create calls CreateFunc.
+func (f *Builds) Create(ctx context.Context, org string, pipeline string, b buildkite.CreateBuild) (buildkite.Build, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Build
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Builds.Create")
+	}
+	return f.CreateFunc(ctx, org, pipeline, b)
+}
+
+// CreateAndWait calls CreateAndWaitFunc.
+func (f *Builds) CreateAndWait(ctx context.Context, org string, pipeline string, b buildkite.CreateBuild, opt *buildkite.WaitForBuildOptions) (buildkite.Build, buildkite.BuildOutcome, error) {
+	if f.CreateAndWaitFunc == nil {
+		var r0 buildkite.Build
+		var r1 buildkite.BuildOutcome
+		return r0, r1, notImplemented("Builds.CreateAndWait")
+	}
+	return f.CreateAndWaitFunc(ctx, org, pipeline, b, opt)
+}
+
+// Get calls GetFunc.
+func (f *Builds) Get(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.BuildGetOptions) (buildkite.Build, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Build
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Builds.Get")
+	}
+	return f.GetFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// List calls ListFunc.
+func (f *Builds) List(ctx context.Context, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Build
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Builds.List")
+	}
+	return f.ListFunc(ctx, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Builds) ListAll(ctx context.Context, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Build, error) bool) {
+			var zero buildkite.Build
+			yield(zero, notImplemented("Builds.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, opt)
+}
+
+// ListByOrg calls ListByOrgFunc.
+func (f *Builds) ListByOrg(ctx context.Context, org string, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error) {
+	if f.ListByOrgFunc == nil {
+		var r0 []buildkite.Build
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Builds.ListByOrg")
+	}
+	return f.ListByOrgFunc(ctx, org, opt)
+}
+
+// ListByOrgAll calls ListByOrgAllFunc.
+func (f *Builds) ListByOrgAll(ctx context.Context, org string, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error] {
+	if f.ListByOrgAllFunc == nil {
+		return func(yield func(buildkite.Build, error) bool) {
+			var zero buildkite.Build
+			yield(zero, notImplemented("Builds.ListByOrgAll"))
+		}
+	}
+	return f.ListByOrgAllFunc(ctx, org, opt)
+}
+
+// ListByPipeline calls ListByPipelineFunc.
+func (f *Builds) ListByPipeline(ctx context.Context, org string, pipeline string, opt *buildkite.BuildsListOptions) ([]buildkite.Build, *buildkite.Response, error) {
+	if f.ListByPipelineFunc == nil {
+		var r0 []buildkite.Build
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Builds.ListByPipeline")
+	}
+	return f.ListByPipelineFunc(ctx, org, pipeline, opt)
+}
+
+// ListByPipelineAll calls ListByPipelineAllFunc.
+func (f *Builds) ListByPipelineAll(ctx context.Context, org string, pipeline string, opt *buildkite.BuildsListOptions) iter.Seq2[buildkite.Build, error] {
+	if f.ListByPipelineAllFunc == nil {
+		return func(yield func(buildkite.Build, error) bool) {
+			var zero buildkite.Build
+			yield(zero, notImplemented("Builds.ListByPipelineAll"))
+		}
+	}
+	return f.ListByPipelineAllFunc(ctx, org, pipeline, opt)
+}
+
+// Rebuild calls RebuildFunc.
+func (f *Builds) Rebuild(ctx context.Context, org string, pipeline string, buildNumber string) (buildkite.Build, error) {
+	if f.RebuildFunc == nil {
+		var r0 buildkite.Build
+		return r0, notImplemented("Builds.Rebuild")
+	}
+	return f.RebuildFunc(ctx, org, pipeline, buildNumber)
+}
+
+// Tree calls TreeFunc.
+func (f *Builds) Tree(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.BuildTreeOptions) (*buildkite.BuildTree, error) {
+	if f.TreeFunc == nil {
+		var r0 *buildkite.BuildTree
+		return r0, notImplemented("Builds.Tree")
+	}
+	return f.TreeFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// WaitForBuild calls WaitForBuildFunc.
+func (f *Builds) WaitForBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.WaitForBuildOptions) (buildkite.Build, buildkite.BuildOutcome, error) {
+	if f.WaitForBuildFunc == nil {
+		var r0 buildkite.Build
+		var r1 buildkite.BuildOutcome
+		return r0, r1, notImplemented("Builds.WaitForBuild")
+	}
+	return f.WaitForBuildFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// ClusterMaintainers is a fake buildkite.ClusterMaintainersAPI.
+type ClusterMaintainers struct {
+	CreateFunc  func(ctx context.Context, org string, clusterID string, input buildkite.ClusterMaintainer) (buildkite.ClusterMaintainerEntry, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, clusterID string, id string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, clusterID string, id string) (buildkite.ClusterMaintainerEntry, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterMaintainersListOptions) ([]buildkite.ClusterMaintainerEntry, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterMaintainersListOptions) iter.Seq2[buildkite.ClusterMaintainerEntry, error]
+}
+
+var _ buildkite.ClusterMaintainersAPI = (*ClusterMaintainers)(nil)
+
+// Create calls CreateFunc.
+func (f *ClusterMaintainers) Create(ctx context.Context, org string, clusterID string, input buildkite.ClusterMaintainer) (buildkite.ClusterMaintainerEntry, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.ClusterMaintainerEntry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterMaintainers.Create")
+	}
+	return f.CreateFunc(ctx, org, clusterID, input)
+}
+
+// Delete calls DeleteFunc.
+func (f *ClusterMaintainers) Delete(ctx context.Context, org string, clusterID string, id string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterMaintainers.Delete")
+	}
+	return f.DeleteFunc(ctx, org, clusterID, id)
+}
+
+// Get calls GetFunc.
+func (f *ClusterMaintainers) Get(ctx context.Context, org string, clusterID string, id string) (buildkite.ClusterMaintainerEntry, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.ClusterMaintainerEntry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterMaintainers.Get")
+	}
+	return f.GetFunc(ctx, org, clusterID, id)
+}
+
+// List calls ListFunc.
+func (f *ClusterMaintainers) List(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterMaintainersListOptions) ([]buildkite.ClusterMaintainerEntry, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.ClusterMaintainerEntry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterMaintainers.List")
+	}
+	return f.ListFunc(ctx, org, clusterID, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *ClusterMaintainers) ListAll(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterMaintainersListOptions) iter.Seq2[buildkite.ClusterMaintainerEntry, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.ClusterMaintainerEntry, error) bool) {
+			var zero buildkite.ClusterMaintainerEntry
+			yield(zero, notImplemented("ClusterMaintainers.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, clusterID, opt)
+}
+
+// ClusterQueues is a fake buildkite.ClusterQueuesAPI.
+type ClusterQueues struct {
+	CreateFunc  func(ctx context.Context, org string, clusterID string, qc buildkite.ClusterQueueCreate) (buildkite.ClusterQueue, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, clusterID string, queueID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, clusterID string, queueID string) (buildkite.ClusterQueue, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterQueuesListOptions) ([]buildkite.ClusterQueue, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterQueuesListOptions) iter.Seq2[buildkite.ClusterQueue, error]
+	PauseFunc   func(ctx context.Context, org string, clusterID string, queueID string, qp buildkite.ClusterQueuePause) (buildkite.ClusterQueue, *buildkite.Response, error)
+	ResumeFunc  func(ctx context.Context, org string, clusterID string, queueID string) (*buildkite.Response, error)
+	UpdateFunc  func(ctx context.Context, org string, clusterID string, queueID string, qu buildkite.ClusterQueueUpdate) (buildkite.ClusterQueue, *buildkite.Response, error)
+}
+
+var _ buildkite.ClusterQueuesAPI = (*ClusterQueues)(nil)
+
+// Create calls CreateFunc.
+func (f *ClusterQueues) Create(ctx context.Context, org string, clusterID string, qc buildkite.ClusterQueueCreate) (buildkite.ClusterQueue, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.ClusterQueue
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterQueues.Create")
+	}
+	return f.CreateFunc(ctx, org, clusterID, qc)
+}
+
+// Delete calls DeleteFunc.
+func (f *ClusterQueues) Delete(ctx context.Context, org string, clusterID string, queueID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterQueues.Delete")
+	}
+	return f.DeleteFunc(ctx, org, clusterID, queueID)
+}
+
+// Get calls GetFunc.
+func (f *ClusterQueues) Get(ctx context.Context, org string, clusterID string, queueID string) (buildkite.ClusterQueue, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.ClusterQueue
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterQueues.Get")
+	}
+	return f.GetFunc(ctx, org, clusterID, queueID)
+}
+
+// List calls ListFunc.
+func (f *ClusterQueues) List(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterQueuesListOptions) ([]buildkite.ClusterQueue, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.ClusterQueue
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterQueues.List")
+	}
+	return f.ListFunc(ctx, org, clusterID, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *ClusterQueues) ListAll(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterQueuesListOptions) iter.Seq2[buildkite.ClusterQueue, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.ClusterQueue, error) bool) {
+			var zero buildkite.ClusterQueue
+			yield(zero, notImplemented("ClusterQueues.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, clusterID, opt)
+}
+
+// Pause calls PauseFunc.
+func (f *ClusterQueues) Pause(ctx context.Context, org string, clusterID string, queueID string, qp buildkite.ClusterQueuePause) (buildkite.ClusterQueue, *buildkite.Response, error) {
+	if f.PauseFunc == nil {
+		var r0 buildkite.ClusterQueue
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterQueues.Pause")
+	}
+	return f.PauseFunc(ctx, org, clusterID, queueID, qp)
+}
+
+// Resume calls ResumeFunc.
+func (f *ClusterQueues) Resume(ctx context.Context, org string, clusterID string, queueID string) (*buildkite.Response, error) {
+	if f.ResumeFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterQueues.Resume")
+	}
+	return f.ResumeFunc(ctx, org, clusterID, queueID)
+}
+
+// Update calls UpdateFunc.
+func (f *ClusterQueues) Update(ctx context.Context, org string, clusterID string, queueID string, qu buildkite.ClusterQueueUpdate) (buildkite.ClusterQueue, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.ClusterQueue
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterQueues.Update")
+	}
+	return f.UpdateFunc(ctx, org, clusterID, queueID, qu)
+}
+
+// ClusterSecrets is a fake buildkite.ClusterSecretsAPI.
+type ClusterSecrets struct {
+	CreateFunc      func(ctx context.Context, org string, clusterID string, input buildkite.ClusterSecretCreate) (buildkite.ClusterSecret, *buildkite.Response, error)
+	DeleteFunc      func(ctx context.Context, org string, clusterID string, secretID string) (*buildkite.Response, error)
+	GetFunc         func(ctx context.Context, org string, clusterID string, secretID string) (buildkite.ClusterSecret, *buildkite.Response, error)
+	ListFunc        func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterSecretsListOptions) ([]buildkite.ClusterSecret, *buildkite.Response, error)
+	ListAllFunc     func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterSecretsListOptions) iter.Seq2[buildkite.ClusterSecret, error]
+	UpdateFunc      func(ctx context.Context, org string, clusterID string, secretID string, input buildkite.ClusterSecretUpdate) (buildkite.ClusterSecret, *buildkite.Response, error)
+	UpdateValueFunc func(ctx context.Context, org string, clusterID string, secretID string, input buildkite.ClusterSecretValueUpdate) (*buildkite.Response, error)
+}
+
+var _ buildkite.ClusterSecretsAPI = (*ClusterSecrets)(nil)
+
+// Create calls CreateFunc.
+func (f *ClusterSecrets) Create(ctx context.Context, org string, clusterID string, input buildkite.ClusterSecretCreate) (buildkite.ClusterSecret, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.ClusterSecret
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterSecrets.Create")
+	}
+	return f.CreateFunc(ctx, org, clusterID, input)
+}
+
+// Delete calls DeleteFunc.
+func (f *ClusterSecrets) Delete(ctx context.Context, org string, clusterID string, secretID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterSecrets.Delete")
+	}
+	return f.DeleteFunc(ctx, org, clusterID, secretID)
+}
+
+// Get calls GetFunc.
+func (f *ClusterSecrets) Get(ctx context.Context, org string, clusterID string, secretID string) (buildkite.ClusterSecret, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.ClusterSecret
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterSecrets.Get")
+	}
+	return f.GetFunc(ctx, org, clusterID, secretID)
+}
+
+// List calls ListFunc.
+func (f *ClusterSecrets) List(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterSecretsListOptions) ([]buildkite.ClusterSecret, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.ClusterSecret
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterSecrets.List")
+	}
+	return f.ListFunc(ctx, org, clusterID, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *ClusterSecrets) ListAll(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterSecretsListOptions) iter.Seq2[buildkite.ClusterSecret, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.ClusterSecret, error) bool) {
+			var zero buildkite.ClusterSecret
+			yield(zero, notImplemented("ClusterSecrets.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, clusterID, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *ClusterSecrets) Update(ctx context.Context, org string, clusterID string, secretID string, input buildkite.ClusterSecretUpdate) (buildkite.ClusterSecret, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.ClusterSecret
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterSecrets.Update")
+	}
+	return f.UpdateFunc(ctx, org, clusterID, secretID, input)
+}
+
+// UpdateValue calls UpdateValueFunc.
+func (f *ClusterSecrets) UpdateValue(ctx context.Context, org string, clusterID string, secretID string, input buildkite.ClusterSecretValueUpdate) (*buildkite.Response, error) {
+	if f.UpdateValueFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterSecrets.UpdateValue")
+	}
+	return f.UpdateValueFunc(ctx, org, clusterID, secretID, input)
+}
+
+// ClusterTokens is a fake buildkite.ClusterTokensAPI.
+type ClusterTokens struct {
+	CreateFunc  func(ctx context.Context, org string, clusterID string, ctc buildkite.ClusterTokenCreate) (buildkite.ClusterToken, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, clusterID string, tokenID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, clusterID string, tokenID string) (buildkite.ClusterToken, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterTokensListOptions) ([]buildkite.ClusterToken, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterTokensListOptions) iter.Seq2[buildkite.ClusterToken, error]
+	UpdateFunc  func(ctx context.Context, org string, clusterID string, tokenID string, ctc buildkite.ClusterTokenUpdate) (buildkite.ClusterToken, *buildkite.Response, error)
+}
+
+var _ buildkite.ClusterTokensAPI = (*ClusterTokens)(nil)
+
+// Create calls CreateFunc.
+func (f *ClusterTokens) Create(ctx context.Context, org string, clusterID string, ctc buildkite.ClusterTokenCreate) (buildkite.ClusterToken, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.ClusterToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterTokens.Create")
+	}
+	return f.CreateFunc(ctx, org, clusterID, ctc)
+}
+
+// Delete calls DeleteFunc.
+func (f *ClusterTokens) Delete(ctx context.Context, org string, clusterID string, tokenID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("ClusterTokens.Delete")
+	}
+	return f.DeleteFunc(ctx, org, clusterID, tokenID)
+}
+
+// Get calls GetFunc.
+func (f *ClusterTokens) Get(ctx context.Context, org string, clusterID string, tokenID string) (buildkite.ClusterToken, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.ClusterToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterTokens.Get")
+	}
+	return f.GetFunc(ctx, org, clusterID, tokenID)
+}
+
+// List calls ListFunc.
+func (f *ClusterTokens) List(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterTokensListOptions) ([]buildkite.ClusterToken, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.ClusterToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterTokens.List")
+	}
+	return f.ListFunc(ctx, org, clusterID, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *ClusterTokens) ListAll(ctx context.Context, org string, clusterID string, opt *buildkite.ClusterTokensListOptions) iter.Seq2[buildkite.ClusterToken, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.ClusterToken, error) bool) {
+			var zero buildkite.ClusterToken
+			yield(zero, notImplemented("ClusterTokens.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, clusterID, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *ClusterTokens) Update(ctx context.Context, org string, clusterID string, tokenID string, ctc buildkite.ClusterTokenUpdate) (buildkite.ClusterToken, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.ClusterToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("ClusterTokens.Update")
+	}
+	return f.UpdateFunc(ctx, org, clusterID, tokenID, ctc)
+}
+
+// Clusters is a fake buildkite.ClustersAPI.
+type Clusters struct {
+	CreateFunc  func(ctx context.Context, org string, cc buildkite.ClusterCreate) (buildkite.Cluster, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, id string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, id string) (buildkite.Cluster, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.ClustersListOptions) ([]buildkite.Cluster, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.ClustersListOptions) iter.Seq2[buildkite.Cluster, error]
+	UpdateFunc  func(ctx context.Context, org string, id string, cu buildkite.ClusterUpdate) (buildkite.Cluster, *buildkite.Response, error)
+}
+
+var _ buildkite.ClustersAPI = (*Clusters)(nil)
+
+// Create calls CreateFunc.
+func (f *Clusters) Create(ctx context.Context, org string, cc buildkite.ClusterCreate) (buildkite.Cluster, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Cluster
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Clusters.Create")
+	}
+	return f.CreateFunc(ctx, org, cc)
+}
+
+// Delete calls DeleteFunc.
+func (f *Clusters) Delete(ctx context.Context, org string, id string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Clusters.Delete")
+	}
+	return f.DeleteFunc(ctx, org, id)
+}
+
+// Get calls GetFunc.
+func (f *Clusters) Get(ctx context.Context, org string, id string) (buildkite.Cluster, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Cluster
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Clusters.Get")
+	}
+	return f.GetFunc(ctx, org, id)
+}
+
+// List calls ListFunc.
+func (f *Clusters) List(ctx context.Context, org string, opt *buildkite.ClustersListOptions) ([]buildkite.Cluster, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Cluster
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Clusters.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Clusters) ListAll(ctx context.Context, org string, opt *buildkite.ClustersListOptions) iter.Seq2[buildkite.Cluster, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Cluster, error) bool) {
+			var zero buildkite.Cluster
+			yield(zero, notImplemented("Clusters.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *Clusters) Update(ctx context.Context, org string, id string, cu buildkite.ClusterUpdate) (buildkite.Cluster, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.Cluster
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Clusters.Update")
+	}
+	return f.UpdateFunc(ctx, org, id, cu)
+}
+
+// Emojis is a fake buildkite.EmojisAPI.
+type Emojis struct {
+	ListFunc func(ctx context.Context, org string) ([]buildkite.Emoji, *buildkite.Response, error)
+}
+
+var _ buildkite.EmojisAPI = (*Emojis)(nil)
+
+// List calls ListFunc.
+func (f *Emojis) List(ctx context.Context, org string) ([]buildkite.Emoji, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Emoji
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Emojis.List")
+	}
+	return f.ListFunc(ctx, org)
+}
+
+// FlakyTests is a fake buildkite.FlakyTestsAPI.
+type FlakyTests struct {
+	ListFunc    func(ctx context.Context, org string, slug string, opt *buildkite.FlakyTestsListOptions) ([]buildkite.FlakyTest, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, slug string, opt *buildkite.FlakyTestsListOptions) iter.Seq2[buildkite.FlakyTest, error]
+}
+
+var _ buildkite.FlakyTestsAPI = (*FlakyTests)(nil)
+
+// List calls ListFunc.
+func (f *FlakyTests) List(ctx context.Context, org string, slug string, opt *buildkite.FlakyTestsListOptions) ([]buildkite.FlakyTest, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.FlakyTest
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("FlakyTests.List")
+	}
+	return f.ListFunc(ctx, org, slug, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *FlakyTests) ListAll(ctx context.Context, org string, slug string, opt *buildkite.FlakyTestsListOptions) iter.Seq2[buildkite.FlakyTest, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.FlakyTest, error) bool) {
+			var zero buildkite.FlakyTest
+			yield(zero, notImplemented("FlakyTests.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, slug, opt)
+}
+
+// GraphQL is a fake buildkite.GraphQLAPI.
+type GraphQL struct {
+	DoFunc func(ctx context.Context, query string, vars map[string]any, out any) (*buildkite.Response, error)
+}
+
+var _ buildkite.GraphQLAPI = (*GraphQL)(nil)
+
+// Do calls DoFunc.
+func (f *GraphQL) Do(ctx context.Context, query string, vars map[string]any, out any) (*buildkite.Response, error) {
+	if f.DoFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("GraphQL.Do")
+	}
+	return f.DoFunc(ctx, query, vars, out)
+}
+
+// Jobs is a fake buildkite.JobsAPI.
+type Jobs struct {
+	DeleteJobLogFunc               func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (*buildkite.Response, error)
+	GetJobFunc                     func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.Job, *buildkite.Response, error)
+	GetJobByOrgFunc                func(ctx context.Context, org string, jobID string) (buildkite.Job, *buildkite.Response, error)
+	GetJobEnvironmentVariablesFunc func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.JobEnvs, *buildkite.Response, error)
+	GetJobLogFunc                  func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.JobLog, *buildkite.Response, error)
+	JobLogExistsFunc               func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (bool, *buildkite.Response, error)
+	ListByBuildFunc                func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.JobsListOptions) (buildkite.JobsList, *buildkite.Response, error)
+	ListByBuildAllFunc             func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.JobsListOptions) iter.Seq2[buildkite.Job, error]
+	ReprioritizeJobFunc            func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *buildkite.JobReprioritizationOptions) (buildkite.Job, *buildkite.Response, error)
+	RetryJobFunc                   func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.Job, *buildkite.Response, error)
+	UnblockJobFunc                 func(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *buildkite.JobUnblockOptions) (buildkite.Job, *buildkite.Response, error)
+}
+
+var _ buildkite.JobsAPI = (*Jobs)(nil)
+
+// DeleteJobLog calls DeleteJobLogFunc.
+func (f *Jobs) DeleteJobLog(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (*buildkite.Response, error) {
+	if f.DeleteJobLogFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Jobs.DeleteJobLog")
+	}
+	return f.DeleteJobLogFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// GetJob calls GetJobFunc.
+func (f *Jobs) GetJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.Job, *buildkite.Response, error) {
+	if f.GetJobFunc == nil {
+		var r0 buildkite.Job
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.GetJob")
+	}
+	return f.GetJobFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// GetJobByOrg calls GetJobByOrgFunc.
+func (f *Jobs) GetJobByOrg(ctx context.Context, org string, jobID string) (buildkite.Job, *buildkite.Response, error) {
+	if f.GetJobByOrgFunc == nil {
+		var r0 buildkite.Job
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.GetJobByOrg")
+	}
+	return f.GetJobByOrgFunc(ctx, org, jobID)
+}
+
+// GetJobEnvironmentVariables calls GetJobEnvironmentVariablesFunc.
+func (f *Jobs) GetJobEnvironmentVariables(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.JobEnvs, *buildkite.Response, error) {
+	if f.GetJobEnvironmentVariablesFunc == nil {
+		var r0 buildkite.JobEnvs
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.GetJobEnvironmentVariables")
+	}
+	return f.GetJobEnvironmentVariablesFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// GetJobLog calls GetJobLogFunc.
+func (f *Jobs) GetJobLog(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.JobLog, *buildkite.Response, error) {
+	if f.GetJobLogFunc == nil {
+		var r0 buildkite.JobLog
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.GetJobLog")
+	}
+	return f.GetJobLogFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// JobLogExists calls JobLogExistsFunc.
+func (f *Jobs) JobLogExists(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (bool, *buildkite.Response, error) {
+	if f.JobLogExistsFunc == nil {
+		var r0 bool
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.JobLogExists")
+	}
+	return f.JobLogExistsFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// ListByBuild calls ListByBuildFunc.
+func (f *Jobs) ListByBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.JobsListOptions) (buildkite.JobsList, *buildkite.Response, error) {
+	if f.ListByBuildFunc == nil {
+		var r0 buildkite.JobsList
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.ListByBuild")
+	}
+	return f.ListByBuildFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls ListByBuildAllFunc.
+func (f *Jobs) ListByBuildAll(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.JobsListOptions) iter.Seq2[buildkite.Job, error] {
+	if f.ListByBuildAllFunc == nil {
+		return func(yield func(buildkite.Job, error) bool) {
+			var zero buildkite.Job
+			yield(zero, notImplemented("Jobs.ListByBuildAll"))
+		}
+	}
+	return f.ListByBuildAllFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// ReprioritizeJob calls ReprioritizeJobFunc.
+func (f *Jobs) ReprioritizeJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *buildkite.JobReprioritizationOptions) (buildkite.Job, *buildkite.Response, error) {
+	if f.ReprioritizeJobFunc == nil {
+		var r0 buildkite.Job
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.ReprioritizeJob")
+	}
+	return f.ReprioritizeJobFunc(ctx, org, pipeline, buildNumber, jobID, opt)
+}
+
+// RetryJob calls RetryJobFunc.
+func (f *Jobs) RetryJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string) (buildkite.Job, *buildkite.Response, error) {
+	if f.RetryJobFunc == nil {
+		var r0 buildkite.Job
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.RetryJob")
+	}
+	return f.RetryJobFunc(ctx, org, pipeline, buildNumber, jobID)
+}
+
+// UnblockJob calls UnblockJobFunc.
+func (f *Jobs) UnblockJob(ctx context.Context, org string, pipeline string, buildNumber string, jobID string, opt *buildkite.JobUnblockOptions) (buildkite.Job, *buildkite.Response, error) {
+	if f.UnblockJobFunc == nil {
+		var r0 buildkite.Job
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Jobs.UnblockJob")
+	}
+	return f.UnblockJobFunc(ctx, org, pipeline, buildNumber, jobID, opt)
+}
+
+// Members is a fake buildkite.MembersAPI.
+type Members struct {
+	GetFunc     func(ctx context.Context, org string, memberUUID string) (buildkite.Member, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.MemberListOptions) ([]buildkite.Member, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.MemberListOptions) iter.Seq2[buildkite.Member, error]
+}
+
+var _ buildkite.MembersAPI = (*Members)(nil)
+
+// Get calls GetFunc.
+func (f *Members) Get(ctx context.Context, org string, memberUUID string) (buildkite.Member, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Member
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Members.Get")
+	}
+	return f.GetFunc(ctx, org, memberUUID)
+}
+
+// List calls ListFunc.
+func (f *Members) List(ctx context.Context, org string, opt *buildkite.MemberListOptions) ([]buildkite.Member, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Member
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Members.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Members) ListAll(ctx context.Context, org string, opt *buildkite.MemberListOptions) iter.Seq2[buildkite.Member, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Member, error) bool) {
+			var zero buildkite.Member
+			yield(zero, notImplemented("Members.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Meta is a fake buildkite.MetaAPI.
+type Meta struct {
+	GetFunc func(ctx context.Context) (buildkite.Meta, *buildkite.Response, error)
+}
+
+var _ buildkite.MetaAPI = (*Meta)(nil)
+
+// Get calls GetFunc.
+func (f *Meta) Get(ctx context.Context) (buildkite.Meta, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Meta
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Meta.Get")
+	}
+	return f.GetFunc(ctx)
+}
+
+// Organizations is a fake buildkite.OrganizationsAPI.
+type Organizations struct {
+	GetFunc     func(ctx context.Context, slug string) (buildkite.Organization, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, opt *buildkite.OrganizationListOptions) ([]buildkite.Organization, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, opt *buildkite.OrganizationListOptions) iter.Seq2[buildkite.Organization, error]
+}
+
+var _ buildkite.OrganizationsAPI = (*Organizations)(nil)
+
+// Get calls GetFunc.
+func (f *Organizations) Get(ctx context.Context, slug string) (buildkite.Organization, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Organization
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Organizations.Get")
+	}
+	return f.GetFunc(ctx, slug)
+}
+
+// List calls ListFunc.
+func (f *Organizations) List(ctx context.Context, opt *buildkite.OrganizationListOptions) ([]buildkite.Organization, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Organization
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Organizations.List")
+	}
+	return f.ListFunc(ctx, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Organizations) ListAll(ctx context.Context, opt *buildkite.OrganizationListOptions) iter.Seq2[buildkite.Organization, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Organization, error) bool) {
+			var zero buildkite.Organization
+			yield(zero, notImplemented("Organizations.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, opt)
+}
+
+// PackageRegistries is a fake buildkite.PackageRegistriesAPI.
+type PackageRegistries struct {
+	CreateFunc          func(ctx context.Context, organizationSlug string, cpri buildkite.CreatePackageRegistryInput) (buildkite.PackageRegistry, *buildkite.Response, error)
+	DeleteFunc          func(ctx context.Context, organizationSlug string, registrySlug string) (*buildkite.Response, error)
+	GetFunc             func(ctx context.Context, organizationSlug string, registrySlug string) (buildkite.PackageRegistry, *buildkite.Response, error)
+	ListFunc            func(ctx context.Context, organizationSlug string) ([]buildkite.PackageRegistry, *buildkite.Response, error)
+	ListPackagesFunc    func(ctx context.Context, organizationSlug string, registrySlug string, opts *buildkite.RegistryPackagesOptions) (buildkite.RegistryPackages, *buildkite.Response, error)
+	ListPackagesAllFunc func(ctx context.Context, organizationSlug string, registrySlug string, opts *buildkite.RegistryPackagesOptions) iter.Seq2[buildkite.Package, error]
+	UpdateFunc          func(ctx context.Context, organizationSlug string, registrySlug string, upri buildkite.UpdatePackageRegistryInput) (buildkite.PackageRegistry, *buildkite.Response, error)
+}
+
+var _ buildkite.PackageRegistriesAPI = (*PackageRegistries)(nil)
+
+// Create calls CreateFunc.
+func (f *PackageRegistries) Create(ctx context.Context, organizationSlug string, cpri buildkite.CreatePackageRegistryInput) (buildkite.PackageRegistry, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.PackageRegistry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistries.Create")
+	}
+	return f.CreateFunc(ctx, organizationSlug, cpri)
+}
+
+// Delete calls DeleteFunc.
+func (f *PackageRegistries) Delete(ctx context.Context, organizationSlug string, registrySlug string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("PackageRegistries.Delete")
+	}
+	return f.DeleteFunc(ctx, organizationSlug, registrySlug)
+}
+
+// Get calls GetFunc.
+func (f *PackageRegistries) Get(ctx context.Context, organizationSlug string, registrySlug string) (buildkite.PackageRegistry, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.PackageRegistry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistries.Get")
+	}
+	return f.GetFunc(ctx, organizationSlug, registrySlug)
+}
+
+// List calls ListFunc.
+func (f *PackageRegistries) List(ctx context.Context, organizationSlug string) ([]buildkite.PackageRegistry, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.PackageRegistry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistries.List")
+	}
+	return f.ListFunc(ctx, organizationSlug)
+}
+
+// ListPackages calls ListPackagesFunc.
+func (f *PackageRegistries) ListPackages(ctx context.Context, organizationSlug string, registrySlug string, opts *buildkite.RegistryPackagesOptions) (buildkite.RegistryPackages, *buildkite.Response, error) {
+	if f.ListPackagesFunc == nil {
+		var r0 buildkite.RegistryPackages
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistries.ListPackages")
+	}
+	return f.ListPackagesFunc(ctx, organizationSlug, registrySlug, opts)
+}
+
+// ListPackagesAll calls ListPackagesAllFunc.
+func (f *PackageRegistries) ListPackagesAll(ctx context.Context, organizationSlug string, registrySlug string, opts *buildkite.RegistryPackagesOptions) iter.Seq2[buildkite.Package, error] {
+	if f.ListPackagesAllFunc == nil {
+		return func(yield func(buildkite.Package, error) bool) {
+			var zero buildkite.Package
+			yield(zero, notImplemented("PackageRegistries.ListPackagesAll"))
+		}
+	}
+	return f.ListPackagesAllFunc(ctx, organizationSlug, registrySlug, opts)
+}
+
+// Update calls UpdateFunc.
+func (f *PackageRegistries) Update(ctx context.Context, organizationSlug string, registrySlug string, upri buildkite.UpdatePackageRegistryInput) (buildkite.PackageRegistry, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.PackageRegistry
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistries.Update")
+	}
+	return f.UpdateFunc(ctx, organizationSlug, registrySlug, upri)
+}
+
+// PackageRegistryTokens is a fake buildkite.PackageRegistryTokensAPI.
+type PackageRegistryTokens struct {
+	CreateFunc func(ctx context.Context, organizationSlug string, registrySlug string, input buildkite.CreatePackageRegistryTokenInput) (buildkite.PackageRegistryToken, *buildkite.Response, error)
+	DeleteFunc func(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (*buildkite.Response, error)
+	GetFunc    func(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (buildkite.PackageRegistryToken, *buildkite.Response, error)
+	ListFunc   func(ctx context.Context, organizationSlug string, registrySlug string) ([]buildkite.PackageRegistryToken, *buildkite.Response, error)
+	UpdateFunc func(ctx context.Context, organizationSlug string, registrySlug string, tokenID string, input buildkite.UpdatePackageRegistryTokenInput) (buildkite.PackageRegistryToken, *buildkite.Response, error)
+}
+
+var _ buildkite.PackageRegistryTokensAPI = (*PackageRegistryTokens)(nil)
+
+// Create calls CreateFunc.
+func (f *PackageRegistryTokens) Create(ctx context.Context, organizationSlug string, registrySlug string, input buildkite.CreatePackageRegistryTokenInput) (buildkite.PackageRegistryToken, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.PackageRegistryToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistryTokens.Create")
+	}
+	return f.CreateFunc(ctx, organizationSlug, registrySlug, input)
+}
+
+// Delete calls DeleteFunc.
+func (f *PackageRegistryTokens) Delete(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("PackageRegistryTokens.Delete")
+	}
+	return f.DeleteFunc(ctx, organizationSlug, registrySlug, tokenID)
+}
+
+// Get calls GetFunc.
+func (f *PackageRegistryTokens) Get(ctx context.Context, organizationSlug string, registrySlug string, tokenID string) (buildkite.PackageRegistryToken, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.PackageRegistryToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistryTokens.Get")
+	}
+	return f.GetFunc(ctx, organizationSlug, registrySlug, tokenID)
+}
+
+// List calls ListFunc.
+func (f *PackageRegistryTokens) List(ctx context.Context, organizationSlug string, registrySlug string) ([]buildkite.PackageRegistryToken, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.PackageRegistryToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistryTokens.List")
+	}
+	return f.ListFunc(ctx, organizationSlug, registrySlug)
+}
+
+// Update calls UpdateFunc.
+func (f *PackageRegistryTokens) Update(ctx context.Context, organizationSlug string, registrySlug string, tokenID string, input buildkite.UpdatePackageRegistryTokenInput) (buildkite.PackageRegistryToken, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.PackageRegistryToken
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PackageRegistryTokens.Update")
+	}
+	return f.UpdateFunc(ctx, organizationSlug, registrySlug, tokenID, input)
+}
+
+// Packages is a fake buildkite.PackagesAPI.
+type Packages struct {
+	CopyFunc                   func(ctx context.Context, organizationSlug string, sourceRegistrySlug string, packageID string, destinationRegistrySlug string) (buildkite.Package, *buildkite.Response, error)
+	CreateFunc                 func(ctx context.Context, organizationSlug string, registrySlug string, cpi buildkite.CreatePackageInput) (buildkite.Package, *buildkite.Response, error)
+	DeleteFunc                 func(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (*buildkite.Response, error)
+	GetFunc                    func(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (buildkite.Package, *buildkite.Response, error)
+	RequestPresignedUploadFunc func(ctx context.Context, organizationSlug string, registrySlug string) (*buildkite.PackagePresignedUpload, *buildkite.Response, error)
+}
+
+var _ buildkite.PackagesAPI = (*Packages)(nil)
+
+// Copy calls CopyFunc.
+func (f *Packages) Copy(ctx context.Context, organizationSlug string, sourceRegistrySlug string, packageID string, destinationRegistrySlug string) (buildkite.Package, *buildkite.Response, error) {
+	if f.CopyFunc == nil {
+		var r0 buildkite.Package
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Packages.Copy")
+	}
+	return f.CopyFunc(ctx, organizationSlug, sourceRegistrySlug, packageID, destinationRegistrySlug)
+}
+
+// Create calls CreateFunc.
+func (f *Packages) Create(ctx context.Context, organizationSlug string, registrySlug string, cpi buildkite.CreatePackageInput) (buildkite.Package, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Package
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Packages.Create")
+	}
+	return f.CreateFunc(ctx, organizationSlug, registrySlug, cpi)
+}
+
+// Delete calls DeleteFunc.
+func (f *Packages) Delete(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Packages.Delete")
+	}
+	return f.DeleteFunc(ctx, organizationSlug, registrySlug, packageID)
+}
+
+// Get calls GetFunc.
+func (f *Packages) Get(ctx context.Context, organizationSlug string, registrySlug string, packageID string) (buildkite.Package, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Package
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Packages.Get")
+	}
+	return f.GetFunc(ctx, organizationSlug, registrySlug, packageID)
+}
+
+// RequestPresignedUpload calls RequestPresignedUploadFunc.
+func (f *Packages) RequestPresignedUpload(ctx context.Context, organizationSlug string, registrySlug string) (*buildkite.PackagePresignedUpload, *buildkite.Response, error) {
+	if f.RequestPresignedUploadFunc == nil {
+		var r0 *buildkite.PackagePresignedUpload
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Packages.RequestPresignedUpload")
+	}
+	return f.RequestPresignedUploadFunc(ctx, organizationSlug, registrySlug)
+}
+
+// PipelineSchedules is a fake buildkite.PipelineSchedulesAPI.
+type PipelineSchedules struct {
+	CreateFunc  func(ctx context.Context, org string, pipelineSlug string, in buildkite.CreatePipelineSchedule) (buildkite.PipelineSchedule, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, pipelineSlug string, id string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, pipelineSlug string, id string) (buildkite.PipelineSchedule, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, pipelineSlug string, opt *buildkite.PipelineScheduleListOptions) ([]buildkite.PipelineSchedule, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, pipelineSlug string, opt *buildkite.PipelineScheduleListOptions) iter.Seq2[buildkite.PipelineSchedule, error]
+	UpdateFunc  func(ctx context.Context, org string, pipelineSlug string, id string, in buildkite.UpdatePipelineSchedule) (buildkite.PipelineSchedule, *buildkite.Response, error)
+}
+
+var _ buildkite.PipelineSchedulesAPI = (*PipelineSchedules)(nil)
+
+// Create calls CreateFunc.
+func (f *PipelineSchedules) Create(ctx context.Context, org string, pipelineSlug string, in buildkite.CreatePipelineSchedule) (buildkite.PipelineSchedule, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.PipelineSchedule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineSchedules.Create")
+	}
+	return f.CreateFunc(ctx, org, pipelineSlug, in)
+}
+
+// Delete calls DeleteFunc.
+func (f *PipelineSchedules) Delete(ctx context.Context, org string, pipelineSlug string, id string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("PipelineSchedules.Delete")
+	}
+	return f.DeleteFunc(ctx, org, pipelineSlug, id)
+}
+
+// Get calls GetFunc.
+func (f *PipelineSchedules) Get(ctx context.Context, org string, pipelineSlug string, id string) (buildkite.PipelineSchedule, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.PipelineSchedule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineSchedules.Get")
+	}
+	return f.GetFunc(ctx, org, pipelineSlug, id)
+}
+
+// List calls ListFunc.
+func (f *PipelineSchedules) List(ctx context.Context, org string, pipelineSlug string, opt *buildkite.PipelineScheduleListOptions) ([]buildkite.PipelineSchedule, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.PipelineSchedule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineSchedules.List")
+	}
+	return f.ListFunc(ctx, org, pipelineSlug, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *PipelineSchedules) ListAll(ctx context.Context, org string, pipelineSlug string, opt *buildkite.PipelineScheduleListOptions) iter.Seq2[buildkite.PipelineSchedule, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.PipelineSchedule, error) bool) {
+			var zero buildkite.PipelineSchedule
+			yield(zero, notImplemented("PipelineSchedules.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, pipelineSlug, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *PipelineSchedules) Update(ctx context.Context, org string, pipelineSlug string, id string, in buildkite.UpdatePipelineSchedule) (buildkite.PipelineSchedule, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.PipelineSchedule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineSchedules.Update")
+	}
+	return f.UpdateFunc(ctx, org, pipelineSlug, id, in)
+}
+
+// PipelineTemplates is a fake buildkite.PipelineTemplatesAPI.
+type PipelineTemplates struct {
+	CreateFunc  func(ctx context.Context, org string, ptc buildkite.PipelineTemplateCreate) (buildkite.PipelineTemplate, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, templateUUID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, templateUUID string) (buildkite.PipelineTemplate, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.PipelineTemplateListOptions) ([]buildkite.PipelineTemplate, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.PipelineTemplateListOptions) iter.Seq2[buildkite.PipelineTemplate, error]
+	UpdateFunc  func(ctx context.Context, org string, templateUUID string, ptu buildkite.PipelineTemplateUpdate) (buildkite.PipelineTemplate, *buildkite.Response, error)
+}
+
+var _ buildkite.PipelineTemplatesAPI = (*PipelineTemplates)(nil)
+
+// Create calls CreateFunc.
+func (f *PipelineTemplates) Create(ctx context.Context, org string, ptc buildkite.PipelineTemplateCreate) (buildkite.PipelineTemplate, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.PipelineTemplate
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineTemplates.Create")
+	}
+	return f.CreateFunc(ctx, org, ptc)
+}
+
+// Delete calls DeleteFunc.
+func (f *PipelineTemplates) Delete(ctx context.Context, org string, templateUUID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("PipelineTemplates.Delete")
+	}
+	return f.DeleteFunc(ctx, org, templateUUID)
+}
+
+// Get calls GetFunc.
+func (f *PipelineTemplates) Get(ctx context.Context, org string, templateUUID string) (buildkite.PipelineTemplate, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.PipelineTemplate
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineTemplates.Get")
+	}
+	return f.GetFunc(ctx, org, templateUUID)
+}
+
+// List calls ListFunc.
+func (f *PipelineTemplates) List(ctx context.Context, org string, opt *buildkite.PipelineTemplateListOptions) ([]buildkite.PipelineTemplate, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.PipelineTemplate
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineTemplates.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *PipelineTemplates) ListAll(ctx context.Context, org string, opt *buildkite.PipelineTemplateListOptions) iter.Seq2[buildkite.PipelineTemplate, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.PipelineTemplate, error) bool) {
+			var zero buildkite.PipelineTemplate
+			yield(zero, notImplemented("PipelineTemplates.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *PipelineTemplates) Update(ctx context.Context, org string, templateUUID string, ptu buildkite.PipelineTemplateUpdate) (buildkite.PipelineTemplate, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.PipelineTemplate
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("PipelineTemplates.Update")
+	}
+	return f.UpdateFunc(ctx, org, templateUUID, ptu)
+}
+
+// Pipelines is a fake buildkite.PipelinesAPI.
+type Pipelines struct {
+	AddWebhookFunc func(ctx context.Context, org string, slug string) (*buildkite.Response, error)
+	ArchiveFunc    func(ctx context.Context, org string, slug string) (*buildkite.Response, error)
+	CreateFunc     func(ctx context.Context, org string, p buildkite.CreatePipeline) (buildkite.Pipeline, *buildkite.Response, error)
+	DeleteFunc     func(ctx context.Context, org string, slug string) (*buildkite.Response, error)
+	GetFunc        func(ctx context.Context, org string, slug string) (buildkite.Pipeline, *buildkite.Response, error)
+	ListFunc       func(ctx context.Context, org string, opt *buildkite.PipelineListOptions) ([]buildkite.Pipeline, *buildkite.Response, error)
+	ListAllFunc    func(ctx context.Context, org string, opt *buildkite.PipelineListOptions) iter.Seq2[buildkite.Pipeline, error]
+	UnarchiveFunc  func(ctx context.Context, org string, slug string) (*buildkite.Response, error)
+	UpdateFunc     func(ctx context.Context, org string, slug string, up buildkite.UpdatePipeline) (buildkite.Pipeline, *buildkite.Response, error)
+}
+
+var _ buildkite.PipelinesAPI = (*Pipelines)(nil)
+
+// AddWebhook calls AddWebhookFunc.
+func (f *Pipelines) AddWebhook(ctx context.Context, org string, slug string) (*buildkite.Response, error) {
+	if f.AddWebhookFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Pipelines.AddWebhook")
+	}
+	return f.AddWebhookFunc(ctx, org, slug)
+}
+
+// Archive calls ArchiveFunc.
+func (f *Pipelines) Archive(ctx context.Context, org string, slug string) (*buildkite.Response, error) {
+	if f.ArchiveFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Pipelines.Archive")
+	}
+	return f.ArchiveFunc(ctx, org, slug)
+}
+
+// Create calls CreateFunc.
+func (f *Pipelines) Create(ctx context.Context, org string, p buildkite.CreatePipeline) (buildkite.Pipeline, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Pipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Pipelines.Create")
+	}
+	return f.CreateFunc(ctx, org, p)
+}
+
+// Delete calls DeleteFunc.
+func (f *Pipelines) Delete(ctx context.Context, org string, slug string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Pipelines.Delete")
+	}
+	return f.DeleteFunc(ctx, org, slug)
+}
+
+// Get calls GetFunc.
+func (f *Pipelines) Get(ctx context.Context, org string, slug string) (buildkite.Pipeline, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Pipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Pipelines.Get")
+	}
+	return f.GetFunc(ctx, org, slug)
+}
+
+// List calls ListFunc.
+func (f *Pipelines) List(ctx context.Context, org string, opt *buildkite.PipelineListOptions) ([]buildkite.Pipeline, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Pipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Pipelines.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Pipelines) ListAll(ctx context.Context, org string, opt *buildkite.PipelineListOptions) iter.Seq2[buildkite.Pipeline, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Pipeline, error) bool) {
+			var zero buildkite.Pipeline
+			yield(zero, notImplemented("Pipelines.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Unarchive calls UnarchiveFunc.
+func (f *Pipelines) Unarchive(ctx context.Context, org string, slug string) (*buildkite.Response, error) {
+	if f.UnarchiveFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Pipelines.Unarchive")
+	}
+	return f.UnarchiveFunc(ctx, org, slug)
+}
+
+// Update calls UpdateFunc.
+func (f *Pipelines) Update(ctx context.Context, org string, slug string, up buildkite.UpdatePipeline) (buildkite.Pipeline, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.Pipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Pipelines.Update")
+	}
+	return f.UpdateFunc(ctx, org, slug, up)
+}
+
+// RateLimit is a fake buildkite.RateLimitAPI.
+type RateLimit struct {
+	GetFunc func(ctx context.Context, org string) (buildkite.RateLimit, *buildkite.Response, error)
+}
+
+var _ buildkite.RateLimitAPI = (*RateLimit)(nil)
+
+// Get calls GetFunc.
+func (f *RateLimit) Get(ctx context.Context, org string) (buildkite.RateLimit, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.RateLimit
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("RateLimit.Get")
+	}
+	return f.GetFunc(ctx, org)
+}
+
+// Rules is a fake buildkite.RulesAPI.
+type Rules struct {
+	CreateFunc  func(ctx context.Context, org string, rc buildkite.RuleCreate) (buildkite.Rule, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, ruleUUID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, ruleUUID string) (buildkite.Rule, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.RulesListOptions) ([]buildkite.Rule, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.RulesListOptions) iter.Seq2[buildkite.Rule, error]
+}
+
+var _ buildkite.RulesAPI = (*Rules)(nil)
+
+// Create calls CreateFunc.
+func (f *Rules) Create(ctx context.Context, org string, rc buildkite.RuleCreate) (buildkite.Rule, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.Rule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Rules.Create")
+	}
+	return f.CreateFunc(ctx, org, rc)
+}
+
+// Delete calls DeleteFunc.
+func (f *Rules) Delete(ctx context.Context, org string, ruleUUID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Rules.Delete")
+	}
+	return f.DeleteFunc(ctx, org, ruleUUID)
+}
+
+// Get calls GetFunc.
+func (f *Rules) Get(ctx context.Context, org string, ruleUUID string) (buildkite.Rule, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Rule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Rules.Get")
+	}
+	return f.GetFunc(ctx, org, ruleUUID)
+}
+
+// List calls ListFunc.
+func (f *Rules) List(ctx context.Context, org string, opt *buildkite.RulesListOptions) ([]buildkite.Rule, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Rule
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Rules.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Rules) ListAll(ctx context.Context, org string, opt *buildkite.RulesListOptions) iter.Seq2[buildkite.Rule, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Rule, error) bool) {
+			var zero buildkite.Rule
+			yield(zero, notImplemented("Rules.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// StepUploads is a fake buildkite.StepUploadsAPI.
+type StepUploads struct {
+	GetFunc            func(ctx context.Context, org string, pipeline string, buildNumber string, uploadUUID string) (buildkite.StepUpload, *buildkite.Response, error)
+	ListByBuildFunc    func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.StepUploadsListOptions) (buildkite.StepUploadsList, *buildkite.Response, error)
+	ListByBuildAllFunc func(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.StepUploadsListOptions) iter.Seq2[buildkite.StepUpload, error]
+}
+
+var _ buildkite.StepUploadsAPI = (*StepUploads)(nil)
+
+// Get calls GetFunc.
+func (f *StepUploads) Get(ctx context.Context, org string, pipeline string, buildNumber string, uploadUUID string) (buildkite.StepUpload, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.StepUpload
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("StepUploads.Get")
+	}
+	return f.GetFunc(ctx, org, pipeline, buildNumber, uploadUUID)
+}
+
+// ListByBuild calls ListByBuildFunc.
+func (f *StepUploads) ListByBuild(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.StepUploadsListOptions) (buildkite.StepUploadsList, *buildkite.Response, error) {
+	if f.ListByBuildFunc == nil {
+		var r0 buildkite.StepUploadsList
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("StepUploads.ListByBuild")
+	}
+	return f.ListByBuildFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls ListByBuildAllFunc.
+func (f *StepUploads) ListByBuildAll(ctx context.Context, org string, pipeline string, buildNumber string, opt *buildkite.StepUploadsListOptions) iter.Seq2[buildkite.StepUpload, error] {
+	if f.ListByBuildAllFunc == nil {
+		return func(yield func(buildkite.StepUpload, error) bool) {
+			var zero buildkite.StepUpload
+			yield(zero, notImplemented("StepUploads.ListByBuildAll"))
+		}
+	}
+	return f.ListByBuildAllFunc(ctx, org, pipeline, buildNumber, opt)
+}
+
+// TeamMember is a fake buildkite.TeamMemberAPI.
+type TeamMember struct {
+	CreateTeamMemberFunc   func(ctx context.Context, org string, teamID string, t buildkite.CreateTeamMember) (buildkite.TeamMember, *buildkite.Response, error)
+	DeleteTeamMemberFunc   func(ctx context.Context, org string, teamID string, userID string) (*buildkite.Response, error)
+	GetTeamMemberFunc      func(ctx context.Context, org string, teamID string, userID string) (buildkite.TeamMember, error)
+	ListTeamMembersFunc    func(ctx context.Context, org string, id string, opt *buildkite.TeamMembersListOptions) ([]buildkite.TeamMember, *buildkite.Response, error)
+	ListTeamMembersAllFunc func(ctx context.Context, org string, id string, opt *buildkite.TeamMembersListOptions) iter.Seq2[buildkite.TeamMember, error]
+	UpdateTeamMemberFunc   func(ctx context.Context, org string, teamID string, userID string, role string) (buildkite.TeamMember, *buildkite.Response, error)
+}
+
+var _ buildkite.TeamMemberAPI = (*TeamMember)(nil)
+
+// CreateTeamMember calls CreateTeamMemberFunc.
+func (f *TeamMember) CreateTeamMember(ctx context.Context, org string, teamID string, t buildkite.CreateTeamMember) (buildkite.TeamMember, *buildkite.Response, error) {
+	if f.CreateTeamMemberFunc == nil {
+		var r0 buildkite.TeamMember
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamMember.CreateTeamMember")
+	}
+	return f.CreateTeamMemberFunc(ctx, org, teamID, t)
+}
+
+// DeleteTeamMember calls DeleteTeamMemberFunc.
+func (f *TeamMember) DeleteTeamMember(ctx context.Context, org string, teamID string, userID string) (*buildkite.Response, error) {
+	if f.DeleteTeamMemberFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("TeamMember.DeleteTeamMember")
+	}
+	return f.DeleteTeamMemberFunc(ctx, org, teamID, userID)
+}
+
+// GetTeamMember calls GetTeamMemberFunc.
+func (f *TeamMember) GetTeamMember(ctx context.Context, org string, teamID string, userID string) (buildkite.TeamMember, error) {
+	if f.GetTeamMemberFunc == nil {
+		var r0 buildkite.TeamMember
+		return r0, notImplemented("TeamMember.GetTeamMember")
+	}
+	return f.GetTeamMemberFunc(ctx, org, teamID, userID)
+}
+
+// ListTeamMembers calls ListTeamMembersFunc.
+func (f *TeamMember) ListTeamMembers(ctx context.Context, org string, id string, opt *buildkite.TeamMembersListOptions) ([]buildkite.TeamMember, *buildkite.Response, error) {
+	if f.ListTeamMembersFunc == nil {
+		var r0 []buildkite.TeamMember
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamMember.ListTeamMembers")
+	}
+	return f.ListTeamMembersFunc(ctx, org, id, opt)
+}
+
+// ListTeamMembersAll calls ListTeamMembersAllFunc.
+func (f *TeamMember) ListTeamMembersAll(ctx context.Context, org string, id string, opt *buildkite.TeamMembersListOptions) iter.Seq2[buildkite.TeamMember, error] {
+	if f.ListTeamMembersAllFunc == nil {
+		return func(yield func(buildkite.TeamMember, error) bool) {
+			var zero buildkite.TeamMember
+			yield(zero, notImplemented("TeamMember.ListTeamMembersAll"))
+		}
+	}
+	return f.ListTeamMembersAllFunc(ctx, org, id, opt)
+}
+
+// UpdateTeamMember calls UpdateTeamMemberFunc.
+func (f *TeamMember) UpdateTeamMember(ctx context.Context, org string, teamID string, userID string, role string) (buildkite.TeamMember, *buildkite.Response, error) {
+	if f.UpdateTeamMemberFunc == nil {
+		var r0 buildkite.TeamMember
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamMember.UpdateTeamMember")
+	}
+	return f.UpdateTeamMemberFunc(ctx, org, teamID, userID, role)
+}
+
+// TeamPipelines is a fake buildkite.TeamPipelinesAPI.
+type TeamPipelines struct {
+	CreateFunc  func(ctx context.Context, org string, teamID string, ctp buildkite.CreateTeamPipelines) (buildkite.TeamPipeline, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, teamID string, pipelineID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, teamID string, pipelineID string) (buildkite.TeamPipeline, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, id string, opt *buildkite.TeamPipelinesListOptions) ([]buildkite.TeamPipeline, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, id string, opt *buildkite.TeamPipelinesListOptions) iter.Seq2[buildkite.TeamPipeline, error]
+	UpdateFunc  func(ctx context.Context, org string, teamID string, pipelineID string, utp buildkite.UpdateTeamPipelines) (buildkite.TeamPipeline, *buildkite.Response, error)
+}
+
+var _ buildkite.TeamPipelinesAPI = (*TeamPipelines)(nil)
+
+// Create calls CreateFunc.
+func (f *TeamPipelines) Create(ctx context.Context, org string, teamID string, ctp buildkite.CreateTeamPipelines) (buildkite.TeamPipeline, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.TeamPipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamPipelines.Create")
+	}
+	return f.CreateFunc(ctx, org, teamID, ctp)
+}
+
+// Delete calls DeleteFunc.
+func (f *TeamPipelines) Delete(ctx context.Context, org string, teamID string, pipelineID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("TeamPipelines.Delete")
+	}
+	return f.DeleteFunc(ctx, org, teamID, pipelineID)
+}
+
+// Get calls GetFunc.
+func (f *TeamPipelines) Get(ctx context.Context, org string, teamID string, pipelineID string) (buildkite.TeamPipeline, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.TeamPipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamPipelines.Get")
+	}
+	return f.GetFunc(ctx, org, teamID, pipelineID)
+}
+
+// List calls ListFunc.
+func (f *TeamPipelines) List(ctx context.Context, org string, id string, opt *buildkite.TeamPipelinesListOptions) ([]buildkite.TeamPipeline, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TeamPipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamPipelines.List")
+	}
+	return f.ListFunc(ctx, org, id, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *TeamPipelines) ListAll(ctx context.Context, org string, id string, opt *buildkite.TeamPipelinesListOptions) iter.Seq2[buildkite.TeamPipeline, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TeamPipeline, error) bool) {
+			var zero buildkite.TeamPipeline
+			yield(zero, notImplemented("TeamPipelines.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, id, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *TeamPipelines) Update(ctx context.Context, org string, teamID string, pipelineID string, utp buildkite.UpdateTeamPipelines) (buildkite.TeamPipeline, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.TeamPipeline
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamPipelines.Update")
+	}
+	return f.UpdateFunc(ctx, org, teamID, pipelineID, utp)
+}
+
+// TeamSuites is a fake buildkite.TeamSuitesAPI.
+type TeamSuites struct {
+	CreateFunc  func(ctx context.Context, org string, teamID string, cts buildkite.CreateTeamSuites) (buildkite.TeamSuites, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, teamID string, suiteID string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, teamID string, suiteID string) (buildkite.TeamSuites, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, id string, opt *buildkite.TeamSuitesListOptions) ([]buildkite.TeamSuites, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, id string, opt *buildkite.TeamSuitesListOptions) iter.Seq2[buildkite.TeamSuites, error]
+	UpdateFunc  func(ctx context.Context, org string, teamID string, pipelineID string, utp buildkite.UpdateTeamSuites) (buildkite.TeamSuites, *buildkite.Response, error)
+}
+
+var _ buildkite.TeamSuitesAPI = (*TeamSuites)(nil)
+
+// Create calls CreateFunc.
+func (f *TeamSuites) Create(ctx context.Context, org string, teamID string, cts buildkite.CreateTeamSuites) (buildkite.TeamSuites, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.TeamSuites
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamSuites.Create")
+	}
+	return f.CreateFunc(ctx, org, teamID, cts)
+}
+
+// Delete calls DeleteFunc.
+func (f *TeamSuites) Delete(ctx context.Context, org string, teamID string, suiteID string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("TeamSuites.Delete")
+	}
+	return f.DeleteFunc(ctx, org, teamID, suiteID)
+}
+
+// Get calls GetFunc.
+func (f *TeamSuites) Get(ctx context.Context, org string, teamID string, suiteID string) (buildkite.TeamSuites, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.TeamSuites
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamSuites.Get")
+	}
+	return f.GetFunc(ctx, org, teamID, suiteID)
+}
+
+// List calls ListFunc.
+func (f *TeamSuites) List(ctx context.Context, org string, id string, opt *buildkite.TeamSuitesListOptions) ([]buildkite.TeamSuites, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TeamSuites
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamSuites.List")
+	}
+	return f.ListFunc(ctx, org, id, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *TeamSuites) ListAll(ctx context.Context, org string, id string, opt *buildkite.TeamSuitesListOptions) iter.Seq2[buildkite.TeamSuites, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TeamSuites, error) bool) {
+			var zero buildkite.TeamSuites
+			yield(zero, notImplemented("TeamSuites.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, id, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *TeamSuites) Update(ctx context.Context, org string, teamID string, pipelineID string, utp buildkite.UpdateTeamSuites) (buildkite.TeamSuites, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.TeamSuites
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TeamSuites.Update")
+	}
+	return f.UpdateFunc(ctx, org, teamID, pipelineID, utp)
+}
+
+// Teams is a fake buildkite.TeamsAPI.
+type Teams struct {
+	CreateTeamFunc func(ctx context.Context, org string, t buildkite.CreateTeam) (buildkite.Team, *buildkite.Response, error)
+	DeleteTeamFunc func(ctx context.Context, org string, id string) (*buildkite.Response, error)
+	GetTeamFunc    func(ctx context.Context, org string, id string) (buildkite.Team, error)
+	ListFunc       func(ctx context.Context, org string, opt *buildkite.TeamsListOptions) ([]buildkite.Team, *buildkite.Response, error)
+	ListAllFunc    func(ctx context.Context, org string, opt *buildkite.TeamsListOptions) iter.Seq2[buildkite.Team, error]
+	UpdateTeamFunc func(ctx context.Context, org string, id string, t buildkite.UpdateTeam) (buildkite.Team, *buildkite.Response, error)
+}
+
+var _ buildkite.TeamsAPI = (*Teams)(nil)
+
+// CreateTeam calls CreateTeamFunc.
+func (f *Teams) CreateTeam(ctx context.Context, org string, t buildkite.CreateTeam) (buildkite.Team, *buildkite.Response, error) {
+	if f.CreateTeamFunc == nil {
+		var r0 buildkite.Team
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Teams.CreateTeam")
+	}
+	return f.CreateTeamFunc(ctx, org, t)
+}
+
+// DeleteTeam calls DeleteTeamFunc.
+func (f *Teams) DeleteTeam(ctx context.Context, org string, id string) (*buildkite.Response, error) {
+	if f.DeleteTeamFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("Teams.DeleteTeam")
+	}
+	return f.DeleteTeamFunc(ctx, org, id)
+}
+
+// GetTeam calls GetTeamFunc.
+func (f *Teams) GetTeam(ctx context.Context, org string, id string) (buildkite.Team, error) {
+	if f.GetTeamFunc == nil {
+		var r0 buildkite.Team
+		return r0, notImplemented("Teams.GetTeam")
+	}
+	return f.GetTeamFunc(ctx, org, id)
+}
+
+// List calls ListFunc.
+func (f *Teams) List(ctx context.Context, org string, opt *buildkite.TeamsListOptions) ([]buildkite.Team, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.Team
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Teams.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Teams) ListAll(ctx context.Context, org string, opt *buildkite.TeamsListOptions) iter.Seq2[buildkite.Team, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.Team, error) bool) {
+			var zero buildkite.Team
+			yield(zero, notImplemented("Teams.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// UpdateTeam calls UpdateTeamFunc.
+func (f *Teams) UpdateTeam(ctx context.Context, org string, id string, t buildkite.UpdateTeam) (buildkite.Team, *buildkite.Response, error) {
+	if f.UpdateTeamFunc == nil {
+		var r0 buildkite.Team
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Teams.UpdateTeam")
+	}
+	return f.UpdateTeamFunc(ctx, org, id, t)
+}
+
+// TestRuns is a fake buildkite.TestRunsAPI.
+type TestRuns struct {
+	GetFunc                 func(ctx context.Context, org string, slug string, runID string) (buildkite.TestRun, *buildkite.Response, error)
+	GetFailedExecutionsFunc func(ctx context.Context, org string, slug string, runID string, opt *buildkite.FailedExecutionsOptions) ([]buildkite.FailedExecution, *buildkite.Response, error)
+	ListFunc                func(ctx context.Context, org string, slug string, opt *buildkite.TestRunsListOptions) ([]buildkite.TestRun, *buildkite.Response, error)
+	ListAllFunc             func(ctx context.Context, org string, slug string, opt *buildkite.TestRunsListOptions) iter.Seq2[buildkite.TestRun, error]
+}
+
+var _ buildkite.TestRunsAPI = (*TestRuns)(nil)
+
+// Get calls GetFunc.
+func (f *TestRuns) Get(ctx context.Context, org string, slug string, runID string) (buildkite.TestRun, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.TestRun
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestRuns.Get")
+	}
+	return f.GetFunc(ctx, org, slug, runID)
+}
+
+// GetFailedExecutions calls GetFailedExecutionsFunc.
+func (f *TestRuns) GetFailedExecutions(ctx context.Context, org string, slug string, runID string, opt *buildkite.FailedExecutionsOptions) ([]buildkite.FailedExecution, *buildkite.Response, error) {
+	if f.GetFailedExecutionsFunc == nil {
+		var r0 []buildkite.FailedExecution
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestRuns.GetFailedExecutions")
+	}
+	return f.GetFailedExecutionsFunc(ctx, org, slug, runID, opt)
+}
+
+// List calls ListFunc.
+func (f *TestRuns) List(ctx context.Context, org string, slug string, opt *buildkite.TestRunsListOptions) ([]buildkite.TestRun, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TestRun
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestRuns.List")
+	}
+	return f.ListFunc(ctx, org, slug, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *TestRuns) ListAll(ctx context.Context, org string, slug string, opt *buildkite.TestRunsListOptions) iter.Seq2[buildkite.TestRun, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TestRun, error) bool) {
+			var zero buildkite.TestRun
+			yield(zero, notImplemented("TestRuns.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, slug, opt)
+}
+
+// TestSuites is a fake buildkite.TestSuitesAPI.
+type TestSuites struct {
+	CreateFunc  func(ctx context.Context, org string, ts buildkite.TestSuiteCreate) (buildkite.TestSuite, *buildkite.Response, error)
+	DeleteFunc  func(ctx context.Context, org string, slug string) (*buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, slug string) (buildkite.TestSuite, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, opt *buildkite.TestSuiteListOptions) ([]buildkite.TestSuite, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, opt *buildkite.TestSuiteListOptions) iter.Seq2[buildkite.TestSuite, error]
+	UpdateFunc  func(ctx context.Context, org string, slug string, ts buildkite.TestSuiteUpdate) (buildkite.TestSuite, *buildkite.Response, error)
+}
+
+var _ buildkite.TestSuitesAPI = (*TestSuites)(nil)
+
+// Create calls CreateFunc.
+func (f *TestSuites) Create(ctx context.Context, org string, ts buildkite.TestSuiteCreate) (buildkite.TestSuite, *buildkite.Response, error) {
+	if f.CreateFunc == nil {
+		var r0 buildkite.TestSuite
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestSuites.Create")
+	}
+	return f.CreateFunc(ctx, org, ts)
+}
+
+// Delete calls DeleteFunc.
+func (f *TestSuites) Delete(ctx context.Context, org string, slug string) (*buildkite.Response, error) {
+	if f.DeleteFunc == nil {
+		var r0 *buildkite.Response
+		return r0, notImplemented("TestSuites.Delete")
+	}
+	return f.DeleteFunc(ctx, org, slug)
+}
+
+// Get calls GetFunc.
+func (f *TestSuites) Get(ctx context.Context, org string, slug string) (buildkite.TestSuite, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.TestSuite
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestSuites.Get")
+	}
+	return f.GetFunc(ctx, org, slug)
+}
+
+// List calls ListFunc.
+func (f *TestSuites) List(ctx context.Context, org string, opt *buildkite.TestSuiteListOptions) ([]buildkite.TestSuite, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TestSuite
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestSuites.List")
+	}
+	return f.ListFunc(ctx, org, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *TestSuites) ListAll(ctx context.Context, org string, opt *buildkite.TestSuiteListOptions) iter.Seq2[buildkite.TestSuite, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TestSuite, error) bool) {
+			var zero buildkite.TestSuite
+			yield(zero, notImplemented("TestSuites.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, opt)
+}
+
+// Update calls UpdateFunc.
+func (f *TestSuites) Update(ctx context.Context, org string, slug string, ts buildkite.TestSuiteUpdate) (buildkite.TestSuite, *buildkite.Response, error) {
+	if f.UpdateFunc == nil {
+		var r0 buildkite.TestSuite
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("TestSuites.Update")
+	}
+	return f.UpdateFunc(ctx, org, slug, ts)
+}
+
+// Tests is a fake buildkite.TestsAPI.
+type Tests struct {
+	FindFunc    func(ctx context.Context, org string, slug string, find buildkite.FindTestOptions) (buildkite.Test, *buildkite.Response, error)
+	GetFunc     func(ctx context.Context, org string, slug string, testID string) (buildkite.Test, *buildkite.Response, error)
+	ListFunc    func(ctx context.Context, org string, slug string, opt *buildkite.TestsListOptions) ([]buildkite.TestWithMetrics, *buildkite.Response, error)
+	ListAllFunc func(ctx context.Context, org string, slug string, opt *buildkite.TestsListOptions) iter.Seq2[buildkite.TestWithMetrics, error]
+}
+
+var _ buildkite.TestsAPI = (*Tests)(nil)
+
+// Find calls FindFunc.
+func (f *Tests) Find(ctx context.Context, org string, slug string, find buildkite.FindTestOptions) (buildkite.Test, *buildkite.Response, error) {
+	if f.FindFunc == nil {
+		var r0 buildkite.Test
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Tests.Find")
+	}
+	return f.FindFunc(ctx, org, slug, find)
+}
+
+// Get calls GetFunc.
+func (f *Tests) Get(ctx context.Context, org string, slug string, testID string) (buildkite.Test, *buildkite.Response, error) {
+	if f.GetFunc == nil {
+		var r0 buildkite.Test
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Tests.Get")
+	}
+	return f.GetFunc(ctx, org, slug, testID)
+}
+
+// List calls ListFunc.
+func (f *Tests) List(ctx context.Context, org string, slug string, opt *buildkite.TestsListOptions) ([]buildkite.TestWithMetrics, *buildkite.Response, error) {
+	if f.ListFunc == nil {
+		var r0 []buildkite.TestWithMetrics
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("Tests.List")
+	}
+	return f.ListFunc(ctx, org, slug, opt)
+}
+
+// ListAll calls ListAllFunc.
+func (f *Tests) ListAll(ctx context.Context, org string, slug string, opt *buildkite.TestsListOptions) iter.Seq2[buildkite.TestWithMetrics, error] {
+	if f.ListAllFunc == nil {
+		return func(yield func(buildkite.TestWithMetrics, error) bool) {
+			var zero buildkite.TestWithMetrics
+			yield(zero, notImplemented("Tests.ListAll"))
+		}
+	}
+	return f.ListAllFunc(ctx, org, slug, opt)
+}
+
+// User is a fake buildkite.UserAPI.
+type User struct {
+	CurrentUserFunc func(ctx context.Context) (buildkite.User, *buildkite.Response, error)
+}
+
+var _ buildkite.UserAPI = (*User)(nil)
+
+// CurrentUser calls CurrentUserFunc.
+func (f *User) CurrentUser(ctx context.Context) (buildkite.User, *buildkite.Response, error) {
+	if f.CurrentUserFunc == nil {
+		var r0 buildkite.User
+		var r1 *buildkite.Response
+		return r0, r1, notImplemented("User.CurrentUser")
+	}
+	return f.CurrentUserFunc(ctx)
+}
+
+// Client is a fake buildkite.ClientAPI made up of a fake for each service.
+// Use NewClient to create one with every fake allocated.
+type Client struct {
+	AccessTokens          *AccessTokens
+	Agents                *Agents
+	Annotations           *Annotations
+	Artifacts             *Artifacts
+	BuildTests            *BuildTests
+	Builds                *Builds
+	ClusterMaintainers    *ClusterMaintainers
+	ClusterQueues         *ClusterQueues
+	ClusterSecrets        *ClusterSecrets
+	ClusterTokens         *ClusterTokens
+	Clusters              *Clusters
+	Emojis                *Emojis
+	FlakyTests            *FlakyTests
+	GraphQL               *GraphQL
+	Jobs                  *Jobs
+	Members               *Members
+	Meta                  *Meta
+	Organizations         *Organizations
+	PackageRegistries     *PackageRegistries
+	PackageRegistryTokens *PackageRegistryTokens
+	Packages              *Packages
+	PipelineSchedules     *PipelineSchedules
+	PipelineTemplates     *PipelineTemplates
+	Pipelines             *Pipelines
+	RateLimit             *RateLimit
+	Rules                 *Rules
+	StepUploads           *StepUploads
+	TeamMember            *TeamMember
+	TeamPipelines         *TeamPipelines
+	TeamSuites            *TeamSuites
+	Teams                 *Teams
+	TestRuns              *TestRuns
+	TestSuites            *TestSuites
+	Tests                 *Tests
+	User                  *User
+}
+
+var _ buildkite.ClientAPI = (*Client)(nil)
+
+// NewClient returns a Client whose service fakes are allocated, with no
+// functions set.
+func NewClient() *Client {
+	return &Client{
+		AccessTokens:          &AccessTokens{},
+		Agents:                &Agents{},
+		Annotations:           &Annotations{},
+		Artifacts:             &Artifacts{},
+		BuildTests:            &BuildTests{},
+		Builds:                &Builds{},
+		ClusterMaintainers:    &ClusterMaintainers{},
+		ClusterQueues:         &ClusterQueues{},
+		ClusterSecrets:        &ClusterSecrets{},
+		ClusterTokens:         &ClusterTokens{},
+		Clusters:              &Clusters{},
+		Emojis:                &Emojis{},
+		FlakyTests:            &FlakyTests{},
+		GraphQL:               &GraphQL{},
+		Jobs:                  &Jobs{},
+		Members:               &Members{},
+		Meta:                  &Meta{},
+		Organizations:         &Organizations{},
+		PackageRegistries:     &PackageRegistries{},
+		PackageRegistryTokens: &PackageRegistryTokens{},
+		Packages:              &Packages{},
+		PipelineSchedules:     &PipelineSchedules{},
+		PipelineTemplates:     &PipelineTemplates{},
+		Pipelines:             &Pipelines{},
+		RateLimit:             &RateLimit{},
+		Rules:                 &Rules{},
+		StepUploads:           &StepUploads{},
+		TeamMember:            &TeamMember{},
+		TeamPipelines:         &TeamPipelines{},
+		TeamSuites:            &TeamSuites{},
+		Teams:                 &Teams{},
+		TestRuns:              &TestRuns{},
+		TestSuites:            &TestSuites{},
+		Tests:                 &Tests{},
+		User:                  &User{},
+	}
+}
+
+// AccessTokensAPI returns c.AccessTokens.
+func (c *Client) AccessTokensAPI() buildkite.AccessTokensAPI {
+	return c.AccessTokens
+}
+
+// AgentsAPI returns c.Agents.
+func (c *Client) AgentsAPI() buildkite.AgentsAPI {
+	return c.Agents
+}
+
+// AnnotationsAPI returns c.Annotations.
+func (c *Client) AnnotationsAPI() buildkite.AnnotationsAPI {
+	return c.Annotations
+}
+
+// ArtifactsAPI returns c.Artifacts.
+func (c *Client) ArtifactsAPI() buildkite.ArtifactsAPI {
+	return c.Artifacts
+}
+
+// BuildTestsAPI returns c.BuildTests.
+func (c *Client) BuildTestsAPI() buildkite.BuildTestsAPI {
+	return c.BuildTests
+}
+
+// BuildsAPI returns c.Builds.
+func (c *Client) BuildsAPI() buildkite.BuildsAPI {
+	return c.Builds
+}
+
+// ClusterMaintainersAPI returns c.ClusterMaintainers.
+func (c *Client) ClusterMaintainersAPI() buildkite.ClusterMaintainersAPI {
+	return c.ClusterMaintainers
+}
+
+// ClusterQueuesAPI returns c.ClusterQueues.
+func (c *Client) ClusterQueuesAPI() buildkite.ClusterQueuesAPI {
+	return c.ClusterQueues
+}
+
+// ClusterSecretsAPI returns c.ClusterSecrets.
+func (c *Client) ClusterSecretsAPI() buildkite.ClusterSecretsAPI {
+	return c.ClusterSecrets
+}
+
+// ClusterTokensAPI returns c.ClusterTokens.
+func (c *Client) ClusterTokensAPI() buildkite.ClusterTokensAPI {
+	return c.ClusterTokens
+}
+
+// ClustersAPI returns c.Clusters.
+func (c *Client) ClustersAPI() buildkite.ClustersAPI {
+	return c.Clusters
+}
+
+// EmojisAPI returns c.Emojis.
+func (c *Client) EmojisAPI() buildkite.EmojisAPI {
+	return c.Emojis
+}
+
+// FlakyTestsAPI returns c.FlakyTests.
+func (c *Client) FlakyTestsAPI() buildkite.FlakyTestsAPI {
+	return c.FlakyTests
+}
+
+// GraphQLAPI returns c.GraphQL.
+func (c *Client) GraphQLAPI() buildkite.GraphQLAPI {
+	return c.GraphQL
+}
+
+// JobsAPI returns c.Jobs.
+func (c *Client) JobsAPI() buildkite.JobsAPI {
+	return c.Jobs
+}
+
+// MembersAPI returns c.Members.
+func (c *Client) MembersAPI() buildkite.MembersAPI {
+	return c.Members
+}
+
+// MetaAPI returns c.Meta.
+func (c *Client) MetaAPI() buildkite.MetaAPI {
+	return c.Meta
+}
+
+// OrganizationsAPI returns c.Organizations.
+func (c *Client) OrganizationsAPI() buildkite.OrganizationsAPI {
+	return c.Organizations
+}
+
+// PackageRegistriesAPI returns c.PackageRegistries.
+func (c *Client) PackageRegistriesAPI() buildkite.PackageRegistriesAPI {
+	return c.PackageRegistries
+}
+
+// PackageRegistryTokensAPI returns c.PackageRegistryTokens.
+func (c *Client) PackageRegistryTokensAPI() buildkite.PackageRegistryTokensAPI {
+	return c.PackageRegistryTokens
+}
+
+// PackagesAPI returns c.Packages.
+func (c *Client) PackagesAPI() buildkite.PackagesAPI {
+	return c.Packages
+}
+
+// PipelineSchedulesAPI returns c.PipelineSchedules.
+func (c *Client) PipelineSchedulesAPI() buildkite.PipelineSchedulesAPI {
+	return c.PipelineSchedules
+}
+
+// PipelineTemplatesAPI returns c.PipelineTemplates.
+func (c *Client) PipelineTemplatesAPI() buildkite.PipelineTemplatesAPI {
+	return c.PipelineTemplates
+}
+
+// PipelinesAPI returns c.Pipelines.
+func (c *Client) PipelinesAPI() buildkite.PipelinesAPI {
+	return c.Pipelines
+}
+
+// RateLimitAPI returns c.RateLimit.
+func (c *Client) RateLimitAPI() buildkite.RateLimitAPI {
+	return c.RateLimit
+}
+
+// RulesAPI returns c.Rules.
+func (c *Client) RulesAPI() buildkite.RulesAPI {
+	return c.Rules
+}
+
+// StepUploadsAPI returns c.StepUploads.
+func (c *Client) StepUploadsAPI() buildkite.StepUploadsAPI {
+	return c.StepUploads
+}
+
+// TeamMemberAPI returns c.TeamMember.
+func (c *Client) TeamMemberAPI() buildkite.TeamMemberAPI {
+	return c.TeamMember
+}
+
+// TeamPipelinesAPI returns c.TeamPipelines.
+func (c *Client) TeamPipelinesAPI() buildkite.TeamPipelinesAPI {
+	return c.TeamPipelines
+}
+
+// TeamSuitesAPI returns c.TeamSuites.
+func (c *Client) TeamSuitesAPI() buildkite.TeamSuitesAPI {
+	return c.TeamSuites
+}
+
+// TeamsAPI returns c.Teams.
+func (c *Client) TeamsAPI() buildkite.TeamsAPI {
+	return c.Teams
+}
+
+// TestRunsAPI returns c.TestRuns.
+func (c *Client) TestRunsAPI() buildkite.TestRunsAPI {
+	return c.TestRuns
+}
+
+// TestSuitesAPI returns c.TestSuites.
+func (c *Client) TestSuitesAPI() buildkite.TestSuitesAPI {
+	return c.TestSuites
+}
+
+// TestsAPI returns c.Tests.
+func (c *Client) TestsAPI() buildkite.TestsAPI {
+	return c.Tests
+}
+
+// UserAPI returns c.User.
+func (c *Client) UserAPI() buildkite.UserAPI {
+	return c.User
+}
@@ -0,0 +1,370 @@
+// Package cassette records HTTP interactions with the Buildkite API to a file
+// and replays them, so tests can run offline against real payloads.
+//
+// A Recorder is an http.RoundTripper. Plug it into a buildkite.Client with
+// buildkite.WithHTTPClient:
+//
+//	mode := cassette.ModeReplay
+//	if os.Getenv("BUILDKITE_RECORD") != "" {
+//		mode = cassette.ModeRecord
+//	}
+//	rec, err := cassette.New("testdata/builds.json", mode)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(func() {
+//		if err := rec.Save(); err != nil {
+//			t.Error(err)
+//		}
+//	})
+//
+//	client, err := buildkite.NewOpts(
+//		buildkite.WithTokenAuth(os.Getenv("BUILDKITE_TOKEN")),
+//		buildkite.WithHTTPClient(rec.HTTPClient()),
+//	)
+//
+// In record mode, requests are sent to the API and each request and response
+// is kept, to be written to the cassette file by Save. Authorization and
+// cookie headers, and secret values such as tokens and cluster secret values,
+// are scrubbed before anything is written. In replay mode, requests are
+// answered from the cassette file without touching the network, and a request
+// that matches no recorded interaction fails with ErrNoMatch.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
+)
+
+// ErrNoMatch is returned, wrapped, by a replaying Recorder for a request that
+// matches none of its unplayed interactions.
+var ErrNoMatch = errors.New("cassette: no recorded interaction matches request")
+
+// Mode selects whether a Recorder records or replays.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette file.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records them.
+	ModeRecord
+)
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Interaction is a recorded request and the response to it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the contents of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Body is a recorded request or response body. It is stored in the cassette
+// file as a string if it is valid UTF-8, and base64 encoded otherwise.
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(struct {
+		Base64 string `json:"base64"`
+	}{base64.StdEncoding.EncodeToString(b)})
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	matcher   Matcher
+	scrubbers []func(*Interaction)
+
+	mu       sync.Mutex
+	cassette Cassette
+	played   []bool
+}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport used to send requests in record mode. It
+// defaults to http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithMatcher sets how a replaying Recorder matches requests to recorded
+// interactions. It defaults to DefaultMatcher.
+func WithMatcher(m Matcher) Option {
+	return func(r *Recorder) {
+		r.matcher = m
+	}
+}
+
+// WithScrubber adds a function that removes sensitive data from each
+// interaction before it is recorded, after the built-in scrubbing. In replay
+// mode it is applied to incoming requests before they are matched, so that
+// they compare equal to their scrubbed recordings.
+func WithScrubber(fn func(*Interaction)) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, fn)
+	}
+}
+
+// New returns a Recorder for the cassette file at path. In replay mode the
+// file is loaded and must exist; in record mode it is replaced by Save.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		matcher:   DefaultMatcher,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: loading %s: %w", path, err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: decoding %s: %w", path, err)
+		}
+		r.played = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an http.Client that sends requests through r, for use
+// with buildkite.WithHTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays a single request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: req.Header.Clone(),
+			Body:   body,
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       respBody,
+		},
+	}
+	r.scrub(&interaction)
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	// Scrub the request as it would have been when recorded.
+	live := Interaction{Request: Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	}}
+	r.scrub(&live)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || !r.matcher(live.Request, interaction.Request) {
+			continue
+		}
+		r.played[i] = true
+
+		recorded := interaction.Response
+		header := recorded.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        strconv.Itoa(recorded.StatusCode) + " " + http.StatusText(recorded.StatusCode),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoMatch, req.Method, req.URL)
+}
+
+// Unplayed returns the recorded interactions a replaying Recorder has not
+// yet served, so tests can check that every expected request was made.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.played[i] {
+			unplayed = append(unplayed, interaction)
+		}
+	}
+	return unplayed
+}
+
+// Save writes the recorded interactions to the cassette file, creating its
+// directory if needed. It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("cassette: encoding: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("cassette: saving %s: %w", r.path, err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("cassette: saving %s: %w", r.path, err)
+	}
+	return nil
+}
+
+// readBody reads req's body and replaces it so that it can still be sent.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// scrub removes secrets from an interaction: secret headers, secret query
+// parameters and secret fields in JSON bodies, followed by any scrubbers
+// added with WithScrubber.
+func (r *Recorder) scrub(i *Interaction) {
+	i.Request.Header = redact.Header(i.Request.Header)
+	i.Response.Header = redact.Header(i.Response.Header)
+	i.Request.URL = scrubURL(i.Request.URL)
+
+	path := urlPath(i.Request.URL)
+	i.Request.Body = scrubJSON(i.Request.Body, path)
+	i.Response.Body = scrubJSON(i.Response.Body, path)
+
+	for _, fn := range r.scrubbers {
+		fn(i)
+	}
+}
+
+// scrubJSON returns body with secret fields redacted if it is JSON, and
+// unchanged otherwise.
+func scrubJSON(body Body, path string) Body {
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil {
+		return body
+	}
+	out, err := json.Marshal(redact.JSON(v, redact.ValueIsSecret(path)))
+	if err != nil {
+		return body
+	}
+	return out
+}
@@ -0,0 +1,201 @@
+package cassette_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildkite/go-buildkite/v5"
+	"github.com/buildkite/go-buildkite/v5/buildkitetest"
+	"github.com/buildkite/go-buildkite/v5/buildkitetest/cassette"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newClient(t *testing.T, baseURL string, rec *cassette.Recorder) *buildkite.Client {
+	t.Helper()
+
+	client, err := buildkite.NewOpts(
+		buildkite.WithBaseURL(baseURL),
+		buildkite.WithTokenAuth("s3cret-token"),
+		buildkite.WithHTTPClient(rec.HTTPClient()),
+		buildkite.WithMaxRetries(0),
+	)
+	if err != nil {
+		t.Fatalf("NewOpts() error: %v", err)
+	}
+	return client
+}
+
+// exercise makes the same calls against a client whether it's recording or
+// replaying, and returns what they returned.
+func exercise(t *testing.T, client *buildkite.Client, clusterID string) (buildkite.Build, buildkite.JobsList) {
+	t.Helper()
+	ctx := context.Background()
+
+	build, _, err := client.Builds.Get(ctx, "acme", "web", "1", nil)
+	if err != nil {
+		t.Fatalf("Builds.Get() error: %v", err)
+	}
+
+	jobs, _, err := client.Jobs.ListByBuild(ctx, "acme", "web", "1", &buildkite.JobsListOptions{PerPage: 1})
+	if err != nil {
+		t.Fatalf("Jobs.ListByBuild() error: %v", err)
+	}
+
+	_, _, err = client.ClusterSecrets.Create(ctx, "acme", clusterID, buildkite.ClusterSecretCreate{
+		Key:   "DEPLOY_KEY",
+		Value: "hunter2",
+	})
+	if err != nil {
+		t.Fatalf("ClusterSecrets.Create() error: %v", err)
+	}
+
+	return build, jobs
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "testdata", "builds.json")
+
+	srv := buildkitetest.NewServer(buildkitetest.WithToken("s3cret-token"))
+	srv.AddBuild("acme", "web", buildkite.Build{Jobs: []buildkite.Job{{Label: "test"}, {Label: "lint"}}})
+	cluster := srv.AddCluster("acme", buildkite.Cluster{Name: "Default"})
+
+	rec, err := cassette.New(path, cassette.ModeRecord)
+	if err != nil {
+		t.Fatalf("New(ModeRecord) error: %v", err)
+	}
+	recordedBuild, recordedJobs := exercise(t, newClient(t, srv.URL, rec), cluster.ID)
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %v", err)
+	}
+	for _, secret := range []string{"s3cret-token", "hunter2"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains secret %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "[REDACTED]") {
+		t.Errorf("cassette has nothing redacted:\n%s", data)
+	}
+
+	rec, err = cassette.New(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatalf("New(ModeReplay) error: %v", err)
+	}
+	// The server is closed, so every response has to come from the cassette.
+	replayedBuild, replayedJobs := exercise(t, newClient(t, srv.URL, rec), cluster.ID)
+
+	if diff := cmp.Diff(recordedBuild, replayedBuild); diff != "" {
+		t.Errorf("replayed build diff: (-recorded +replayed)\n%s", diff)
+	}
+	if diff := cmp.Diff(recordedJobs, replayedJobs); diff != "" {
+		t.Errorf("replayed jobs diff: (-recorded +replayed)\n%s", diff)
+	}
+	if unplayed := rec.Unplayed(); len(unplayed) != 0 {
+		t.Errorf("Unplayed() = %d interactions, want none", len(unplayed))
+	}
+
+	_, _, err = newClient(t, srv.URL, rec).Builds.Get(context.Background(), "acme", "web", "2", nil)
+	if !errors.Is(err, cassette.ErrNoMatch) {
+		t.Errorf("Builds.Get() of an unrecorded build error = %v, want ErrNoMatch", err)
+	}
+}
+
+func TestRecorder_BodyMatcher(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "create.json")
+	ctx := context.Background()
+
+	srv := buildkitetest.NewServer()
+	srv.AddPipeline("acme", buildkite.Pipeline{Name: "web"})
+
+	rec, err := cassette.New(path, cassette.ModeRecord)
+	if err != nil {
+		t.Fatalf("New(ModeRecord) error: %v", err)
+	}
+	client := newClient(t, srv.URL, rec)
+	for _, commit := range []string{"aaa", "bbb"} {
+		if _, _, err := client.Builds.Create(ctx, "acme", "web", buildkite.CreateBuild{Commit: commit, Branch: "main"}); err != nil {
+			t.Fatalf("Builds.Create() error: %v", err)
+		}
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	srv.Close()
+
+	matcher := cassette.All(cassette.DefaultMatcher, cassette.MatchBody)
+	rec, err = cassette.New(path, cassette.ModeReplay, cassette.WithMatcher(matcher))
+	if err != nil {
+		t.Fatalf("New(ModeReplay) error: %v", err)
+	}
+	client = newClient(t, srv.URL, rec)
+
+	// Replayed in the opposite order, each request still gets its own response.
+	for _, want := range []struct {
+		commit string
+		number int
+	}{{"bbb", 2}, {"aaa", 1}} {
+		build, _, err := client.Builds.Create(ctx, "acme", "web", buildkite.CreateBuild{Commit: want.commit, Branch: "main"})
+		if err != nil {
+			t.Fatalf("Builds.Create(%s) error: %v", want.commit, err)
+		}
+		if build.Number != want.number {
+			t.Errorf("Builds.Create(%s) number = %d, want %d", want.commit, build.Number, want.number)
+		}
+	}
+}
+
+func TestRecorder_ReplayMissingFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := cassette.New(filepath.Join(t.TempDir(), "missing.json"), cassette.ModeReplay)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("New() error = %v, want os.ErrNotExist", err)
+	}
+}
+
+func TestBody_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body cassette.Body
+		want string
+	}{
+		{name: "text", body: cassette.Body(`{"ok":true}`), want: `"{\"ok\":true}"`},
+		{name: "binary", body: cassette.Body{0xff, 0x00, 0xfe}, want: `{"base64":"/wD+"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.body)
+			if err != nil {
+				t.Fatalf("Marshal() error: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var got cassette.Body
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error: %v", err)
+			}
+			if diff := cmp.Diff(tt.body, got); diff != "" {
+				t.Errorf("round trip diff: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
+)
+
+// Matcher reports whether a live request, scrubbed as it would be when
+// recorded, matches a recorded one.
+type Matcher func(live, recorded Request) bool
+
+// DefaultMatcher matches requests by method, path and query.
+var DefaultMatcher = All(MatchMethod, MatchPath, MatchQuery)
+
+// All returns a Matcher that matches when every one of matchers does.
+func All(matchers ...Matcher) Matcher {
+	return func(live, recorded Request) bool {
+		for _, m := range matchers {
+			if !m(live, recorded) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MatchMethod matches requests with the same HTTP method.
+func MatchMethod(live, recorded Request) bool {
+	return live.Method == recorded.Method
+}
+
+// MatchPath matches requests for the same URL path, regardless of host.
+func MatchPath(live, recorded Request) bool {
+	return urlPath(live.URL) == urlPath(recorded.URL)
+}
+
+// MatchQuery matches requests with the same query parameters, in any order.
+func MatchQuery(live, recorded Request) bool {
+	l, err := url.Parse(live.URL)
+	if err != nil {
+		return false
+	}
+	r, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(l.Query(), r.Query())
+}
+
+// MatchBody matches requests with the same body. JSON bodies match if they
+// decode to equal values, regardless of formatting and key order.
+func MatchBody(live, recorded Request) bool {
+	var l, r any
+	if json.Unmarshal(live.Body, &l) == nil && json.Unmarshal(recorded.Body, &r) == nil {
+		return reflect.DeepEqual(l, r)
+	}
+	return bytes.Equal(live.Body, recorded.Body)
+}
+
+// urlPath returns the path of rawURL, or rawURL itself if it doesn't parse.
+func urlPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Path
+}
+
+// scrubURL returns rawURL with the values of secret query parameters
+// redacted.
+func scrubURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+
+	q := u.Query()
+	changed := false
+	for k := range q {
+		if redact.IsSecretField(k) {
+			q.Set(k, redact.Redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return rawURL
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
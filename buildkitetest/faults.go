@@ -0,0 +1,115 @@
+package buildkitetest
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// Fault describes a failure to inject into requests that reach a Server.
+// Faults are matched in the order they were added, and the first that
+// matches a request applies to it.
+type Fault struct {
+	// Method limits the fault to requests with this HTTP method. Empty
+	// matches any method.
+	Method string
+
+	// Path limits the fault to requests whose URL path matches this
+	// path.Match pattern, such as "/v2/organizations/*/pipelines/*/builds".
+	// Empty matches any path.
+	Path string
+
+	// Latency delays the response by this long. A fault with Latency and no
+	// Status slows matching requests down without failing them.
+	Latency time.Duration
+
+	// Status, when set, answers matching requests with this status code
+	// instead of handling them.
+	Status int
+
+	// Header is added to the response when Status is set, for example to
+	// send RateLimit-Reset or Retry-After.
+	Header http.Header
+
+	// Body is the response body when Status is set. It defaults to a JSON
+	// error message with the status text.
+	Body string
+
+	// Times is how many requests the fault applies to before it is removed.
+	// Zero applies it to every matching request until ClearFaults is called.
+	Times int
+}
+
+// RateLimited returns a Fault answering the next times requests with 429 Too
+// Many Requests, reporting a spent budget that resets after reset.
+func RateLimited(times int, reset time.Duration) Fault {
+	h := http.Header{}
+	h.Set("RateLimit-Limit", "200")
+	h.Set("RateLimit-Remaining", "0")
+	h.Set("RateLimit-Reset", strconv.Itoa(int(reset.Seconds())))
+	return Fault{Status: http.StatusTooManyRequests, Header: h, Times: times}
+}
+
+// fault is an injected Fault and how many more requests it applies to.
+type fault struct {
+	Fault
+	remaining int
+}
+
+// AddFault injects f into the requests the server receives.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{Fault: f, remaining: f.Times})
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// takeFaultLocked returns the first fault matching r, if any, counting r
+// against it.
+func (s *Server) takeFaultLocked(r *http.Request) *fault {
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func (f *fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if f.Path != "" {
+		if ok, err := path.Match(f.Path, r.URL.Path); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// write answers a request with the fault's status, headers and body.
+func (f *fault) write(w http.ResponseWriter) {
+	for k, v := range f.Header {
+		w.Header()[k] = v
+	}
+	if f.Body == "" {
+		writeError(w, f.Status, http.StatusText(f.Status))
+		return
+	}
+	w.WriteHeader(f.Status)
+	_, _ = w.Write([]byte(f.Body))
+}
@@ -0,0 +1,518 @@
+package buildkitetest
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+type org struct {
+	org       buildkite.Organization
+	pipelines []*pipeline
+	agents    []buildkite.Agent
+	clusters  []*cluster
+	teams     []buildkite.Team
+}
+
+type pipeline struct {
+	pipeline  buildkite.Pipeline
+	builds    []*build
+	schedules []buildkite.PipelineSchedule
+}
+
+type build struct {
+	build buildkite.Build
+	seq   int
+}
+
+type cluster struct {
+	cluster buildkite.Cluster
+	queues  []buildkite.ClusterQueue
+	secrets []secret
+}
+
+type secret struct {
+	secret buildkite.ClusterSecret
+	value  string
+}
+
+// AddOrganization adds an organization, filling in its ID, URLs and creation
+// time if unset, and returns it. The slug is derived from the name if unset.
+func (s *Server) AddOrganization(o buildkite.Organization) buildkite.Organization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addOrgLocked(o).org
+}
+
+func (s *Server) addOrgLocked(o buildkite.Organization) *org {
+	if o.Slug == "" {
+		o.Slug = slugify(o.Name)
+	}
+	if o.Name == "" {
+		o.Name = o.Slug
+	}
+	if o.ID == "" {
+		o.ID = s.newIDLocked()
+	}
+	if o.URL == "" {
+		o.URL = fmt.Sprintf("%s/v2/organizations/%s", s.URL, o.Slug)
+	}
+	if o.WebURL == "" {
+		o.WebURL = "https://buildkite.com/" + o.Slug
+	}
+	if o.PipelinesURL == "" {
+		o.PipelinesURL = o.URL + "/pipelines"
+	}
+	if o.AgentsURL == "" {
+		o.AgentsURL = o.URL + "/agents"
+	}
+	if o.CreatedAt == nil {
+		o.CreatedAt = now()
+	}
+
+	if i := slices.IndexFunc(s.orgs, func(x *org) bool { return x.org.Slug == o.Slug }); i >= 0 {
+		s.orgs[i].org = o
+		return s.orgs[i]
+	}
+	rec := &org{org: o}
+	s.orgs = append(s.orgs, rec)
+	return rec
+}
+
+// orgLocked returns the organization with the given slug, adding it first if
+// create is set.
+func (s *Server) orgLocked(slug string, create bool) *org {
+	for _, o := range s.orgs {
+		if o.org.Slug == slug {
+			return o
+		}
+	}
+	if !create {
+		return nil
+	}
+	return s.addOrgLocked(buildkite.Organization{Slug: slug})
+}
+
+// AddPipeline adds a pipeline to the organization with slug org, which is
+// added too if it doesn't exist. It fills in the pipeline's slug, ID, URLs
+// and creation time if unset, and returns it.
+func (s *Server) AddPipeline(org string, p buildkite.Pipeline) buildkite.Pipeline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addPipelineLocked(s.orgLocked(org, true), p).pipeline
+}
+
+func (s *Server) addPipelineLocked(o *org, p buildkite.Pipeline) *pipeline {
+	if p.Slug == "" {
+		p.Slug = slugify(p.Name)
+	}
+	if p.Name == "" {
+		p.Name = p.Slug
+	}
+	if p.ID == "" {
+		p.ID = s.newIDLocked()
+	}
+	if p.URL == "" {
+		p.URL = fmt.Sprintf("%s/pipelines/%s", o.org.URL, p.Slug)
+	}
+	if p.WebURL == "" {
+		p.WebURL = fmt.Sprintf("%s/%s", o.org.WebURL, p.Slug)
+	}
+	if p.BuildsURL == "" {
+		p.BuildsURL = p.URL + "/builds"
+	}
+	if p.DefaultBranch == "" {
+		p.DefaultBranch = "main"
+	}
+	if p.CreatedAt == nil {
+		p.CreatedAt = now()
+	}
+
+	if i := slices.IndexFunc(o.pipelines, func(x *pipeline) bool { return x.pipeline.Slug == p.Slug }); i >= 0 {
+		o.pipelines[i].pipeline = p
+		return o.pipelines[i]
+	}
+	rec := &pipeline{pipeline: p}
+	o.pipelines = append(o.pipelines, rec)
+	return rec
+}
+
+func (o *org) pipeline(slug string) *pipeline {
+	for _, p := range o.pipelines {
+		if p.pipeline.Slug == slug {
+			return p
+		}
+	}
+	return nil
+}
+
+// pipelineLocked returns the pipeline with the given slug, adding it and its
+// organization first if create is set.
+func (s *Server) pipelineLocked(org, slug string, create bool) *pipeline {
+	o := s.orgLocked(org, create)
+	if o == nil {
+		return nil
+	}
+	if p := o.pipeline(slug); p != nil || !create {
+		return p
+	}
+	return s.addPipelineLocked(o, buildkite.Pipeline{Slug: slug})
+}
+
+// Pipeline returns the pipeline with the given slug in org.
+func (s *Server) Pipeline(org, slug string) (buildkite.Pipeline, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pipelineLocked(org, slug, false)
+	if p == nil {
+		return buildkite.Pipeline{}, false
+	}
+	return p.pipeline, true
+}
+
+// AddBuild adds a build to a pipeline, which is added along with its
+// organization if it doesn't exist. The build is numbered after the
+// pipeline's latest build if its number is unset, and its ID, URLs, state,
+// creation time and job IDs are filled in if unset. It returns the build.
+func (s *Server) AddBuild(org, pipeline string, b buildkite.Build) buildkite.Build {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneBuild(s.addBuildLocked(s.pipelineLocked(org, pipeline, true), b).build)
+}
+
+func (s *Server) addBuildLocked(p *pipeline, b buildkite.Build) *build {
+	if b.Number == 0 {
+		b.Number = 1
+		if n := len(p.builds); n > 0 {
+			b.Number = p.builds[n-1].build.Number + 1
+		}
+	}
+	if b.ID == "" {
+		b.ID = s.newIDLocked()
+	}
+	if b.URL == "" {
+		b.URL = fmt.Sprintf("%s/builds/%d", p.pipeline.URL, b.Number)
+	}
+	if b.WebURL == "" {
+		b.WebURL = fmt.Sprintf("%s/builds/%d", p.pipeline.WebURL, b.Number)
+	}
+	if b.State == "" {
+		b.State = buildkite.BuildStateScheduled
+	}
+	if b.Branch == "" {
+		b.Branch = p.pipeline.DefaultBranch
+	}
+	if b.Commit == "" {
+		b.Commit = "HEAD"
+	}
+	if b.CreatedAt == nil {
+		b.CreatedAt = now()
+	}
+	if b.Pipeline == nil {
+		pl := p.pipeline
+		b.Pipeline = &pl
+	}
+	for i := range b.Jobs {
+		s.fillJobLocked(&b, &b.Jobs[i])
+	}
+
+	rec := &build{build: b, seq: s.nextSeqLocked()}
+	p.builds = append(p.builds, rec)
+	slices.SortFunc(p.builds, func(a, b *build) int { return a.build.Number - b.build.Number })
+	return rec
+}
+
+func (s *Server) fillJobLocked(b *buildkite.Build, j *buildkite.Job) {
+	if j.ID == "" {
+		j.ID = s.newIDLocked()
+	}
+	if j.Type == "" {
+		j.Type = buildkite.JobTypeScript
+	}
+	if j.State == "" {
+		j.State = buildkite.JobStateScheduled
+	}
+	if j.BuildURL == "" {
+		j.BuildURL = b.URL
+	}
+	if j.WebURL == "" {
+		j.WebURL = fmt.Sprintf("%s#%s", b.WebURL, j.ID)
+	}
+	if j.CreatedAt == nil {
+		j.CreatedAt = now()
+	}
+}
+
+func (p *pipeline) build(number int) *build {
+	for _, b := range p.builds {
+		if b.build.Number == number {
+			return b
+		}
+	}
+	return nil
+}
+
+// Build returns the build with the given number in a pipeline.
+func (s *Server) Build(org, pipeline string, number int) (buildkite.Build, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pipelineLocked(org, pipeline, false)
+	if p == nil {
+		return buildkite.Build{}, false
+	}
+	b := p.build(number)
+	if b == nil {
+		return buildkite.Build{}, false
+	}
+	return cloneBuild(b.build), true
+}
+
+// cloneBuild copies b's jobs so the caller can't modify the server's copy.
+func cloneBuild(b buildkite.Build) buildkite.Build {
+	b.Jobs = slices.Clone(b.Jobs)
+	return b
+}
+
+// UpdateBuild calls fn with the build with the given number in a pipeline,
+// so that tests can move it through its lifecycle, and reports whether the
+// build exists. Jobs added by fn have their IDs and URLs filled in.
+func (s *Server) UpdateBuild(org, pipeline string, number int, fn func(*buildkite.Build)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pipelineLocked(org, pipeline, false)
+	if p == nil {
+		return false
+	}
+	b := p.build(number)
+	if b == nil {
+		return false
+	}
+	fn(&b.build)
+	for i := range b.build.Jobs {
+		s.fillJobLocked(&b.build, &b.build.Jobs[i])
+	}
+	return true
+}
+
+// UpdateJob calls fn with the job with the given ID in a build, and reports
+// whether the job exists.
+func (s *Server) UpdateJob(org, pipeline string, number int, jobID string, fn func(*buildkite.Job)) bool {
+	found := false
+	s.UpdateBuild(org, pipeline, number, func(b *buildkite.Build) {
+		if i := slices.IndexFunc(b.Jobs, func(j buildkite.Job) bool { return j.ID == jobID }); i >= 0 {
+			fn(&b.Jobs[i])
+			found = true
+		}
+	})
+	return found
+}
+
+// AddAgent adds an agent to an organization, which is added too if it
+// doesn't exist, filling in its ID, URLs and connection state if unset. It
+// returns the agent.
+func (s *Server) AddAgent(org string, a buildkite.Agent) buildkite.Agent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addAgentLocked(s.orgLocked(org, true), a)
+}
+
+func (s *Server) addAgentLocked(o *org, a buildkite.Agent) buildkite.Agent {
+	if a.ID == "" {
+		a.ID = s.newIDLocked()
+	}
+	if a.URL == "" {
+		a.URL = fmt.Sprintf("%s/agents/%s", o.org.URL, a.ID)
+	}
+	if a.WebURL == "" {
+		a.WebURL = fmt.Sprintf("%s/agents/%s", o.org.WebURL, a.ID)
+	}
+	if a.ConnectedState == "" {
+		a.ConnectedState = "connected"
+	}
+	if a.CreatedAt == nil {
+		a.CreatedAt = now()
+	}
+	o.agents = append(o.agents, a)
+	return a
+}
+
+// AddCluster adds a cluster to an organization, which is added too if it
+// doesn't exist, filling in its ID and URLs if unset. It returns the cluster.
+func (s *Server) AddCluster(org string, c buildkite.Cluster) buildkite.Cluster {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addClusterLocked(s.orgLocked(org, true), c).cluster
+}
+
+func (s *Server) addClusterLocked(o *org, c buildkite.Cluster) *cluster {
+	if c.ID == "" {
+		c.ID = s.newIDLocked()
+	}
+	if c.URL == "" {
+		c.URL = fmt.Sprintf("%s/clusters/%s", o.org.URL, c.ID)
+	}
+	if c.WebURL == "" {
+		c.WebURL = fmt.Sprintf("%s/clusters/%s", o.org.WebURL, c.ID)
+	}
+	if c.QueuesURL == "" {
+		c.QueuesURL = c.URL + "/queues"
+	}
+	if c.CreatedAt == nil {
+		c.CreatedAt = now()
+	}
+	rec := &cluster{cluster: c}
+	o.clusters = append(o.clusters, rec)
+	return rec
+}
+
+func (o *org) cluster(id string) *cluster {
+	for _, c := range o.clusters {
+		if c.cluster.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// clusterLocked returns the cluster with the given ID, adding it and its
+// organization first if create is set.
+func (s *Server) clusterLocked(org, id string, create bool) *cluster {
+	o := s.orgLocked(org, create)
+	if o == nil {
+		return nil
+	}
+	if c := o.cluster(id); c != nil || !create {
+		return c
+	}
+	return s.addClusterLocked(o, buildkite.Cluster{ID: id, Name: id})
+}
+
+// AddClusterQueue adds a queue to a cluster, which is added along with its
+// organization if it doesn't exist, filling in its ID and URLs if unset. It
+// returns the queue.
+func (s *Server) AddClusterQueue(org, clusterID string, q buildkite.ClusterQueue) buildkite.ClusterQueue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addQueueLocked(s.clusterLocked(org, clusterID, true), q)
+}
+
+func (s *Server) addQueueLocked(c *cluster, q buildkite.ClusterQueue) buildkite.ClusterQueue {
+	if q.ID == "" {
+		q.ID = s.newIDLocked()
+	}
+	if q.URL == "" {
+		q.URL = fmt.Sprintf("%s/queues/%s", c.cluster.URL, q.ID)
+	}
+	if q.ClusterURL == "" {
+		q.ClusterURL = c.cluster.URL
+	}
+	if q.CreatedAt == nil {
+		q.CreatedAt = now()
+	}
+	c.queues = append(c.queues, q)
+	return q
+}
+
+// AddClusterSecret adds a secret with the given value to a cluster, which is
+// added along with its organization if it doesn't exist, filling in its ID
+// and URLs if unset. It returns the secret.
+func (s *Server) AddClusterSecret(org, clusterID string, sec buildkite.ClusterSecret, value string) buildkite.ClusterSecret {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addSecretLocked(s.orgLocked(org, true), s.clusterLocked(org, clusterID, true), sec, value)
+}
+
+func (s *Server) addSecretLocked(o *org, c *cluster, sec buildkite.ClusterSecret, value string) buildkite.ClusterSecret {
+	if sec.ID == "" {
+		sec.ID = s.newIDLocked()
+	}
+	if sec.URL == "" {
+		sec.URL = fmt.Sprintf("%s/secrets/%s", c.cluster.URL, sec.ID)
+	}
+	if sec.ClusterURL == "" {
+		sec.ClusterURL = c.cluster.URL
+	}
+	if sec.CreatedAt == nil {
+		sec.CreatedAt = now()
+	}
+	if sec.Organization.Slug == "" {
+		sec.Organization = buildkite.ClusterSecretOrganization{
+			ID:     o.org.ID,
+			Slug:   o.org.Slug,
+			URL:    o.org.URL,
+			WebURL: o.org.WebURL,
+		}
+	}
+	c.secrets = append(c.secrets, secret{secret: sec, value: value})
+	return sec
+}
+
+// ClusterSecretValue returns the value of a cluster secret, which the API
+// never returns.
+func (s *Server) ClusterSecretValue(org, clusterID, secretID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.clusterLocked(org, clusterID, false)
+	if c == nil {
+		return "", false
+	}
+	for _, sec := range c.secrets {
+		if sec.secret.ID == secretID {
+			return sec.value, true
+		}
+	}
+	return "", false
+}
+
+// AddTeam adds a team to an organization, which is added too if it doesn't
+// exist, filling in its ID and slug if unset. It returns the team.
+func (s *Server) AddTeam(org string, t buildkite.Team) buildkite.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTeamLocked(s.orgLocked(org, true), t)
+}
+
+func (s *Server) addTeamLocked(o *org, t buildkite.Team) buildkite.Team {
+	if t.ID == "" {
+		t.ID = s.newIDLocked()
+	}
+	if t.Slug == "" {
+		t.Slug = slugify(t.Name)
+	}
+	if t.Privacy == "" {
+		t.Privacy = "visible"
+	}
+	if t.CreatedAt == nil {
+		t.CreatedAt = now()
+	}
+	o.teams = append(o.teams, t)
+	return t
+}
+
+// AddPipelineSchedule adds a schedule to a pipeline, which is added along
+// with its organization if it doesn't exist, filling in its ID and URL if
+// unset. It returns the schedule.
+func (s *Server) AddPipelineSchedule(org, pipeline string, ps buildkite.PipelineSchedule) buildkite.PipelineSchedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addScheduleLocked(s.pipelineLocked(org, pipeline, true), ps)
+}
+
+func (s *Server) addScheduleLocked(p *pipeline, ps buildkite.PipelineSchedule) buildkite.PipelineSchedule {
+	if ps.ID == "" {
+		ps.ID = s.newIDLocked()
+	}
+	if ps.URL == "" {
+		ps.URL = fmt.Sprintf("%s/schedules/%s", p.pipeline.URL, ps.ID)
+	}
+	if ps.CreatedAt == nil {
+		ps.CreatedAt = now()
+	}
+	p.schedules = append(p.schedules, ps)
+	return ps
+}
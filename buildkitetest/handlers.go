@@ -0,0 +1,1134 @@
+package buildkitetest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+func (s *Server) routes() {
+	const (
+		orgs      = "/v2/organizations"
+		org       = orgs + "/{org}"
+		pipelines = org + "/pipelines"
+		pipeline  = pipelines + "/{pipeline}"
+		builds    = pipeline + "/builds"
+		build     = builds + "/{number}"
+		job       = build + "/jobs/{job}"
+		agent     = org + "/agents/{agent}"
+		cluster   = org + "/clusters/{cluster}"
+		queue     = cluster + "/queues/{queue}"
+		secret    = cluster + "/secrets/{secret}"
+		team      = org + "/teams/{team}"
+		schedule  = pipeline + "/schedules/{schedule}"
+	)
+
+	handle := func(pattern string, h http.HandlerFunc) { s.mux.HandleFunc(pattern, h) }
+
+	handle("GET "+orgs, s.listOrganizations)
+	handle("GET "+org, s.getOrganization)
+
+	handle("GET "+pipelines, s.listPipelines)
+	handle("POST "+pipelines, s.createPipeline)
+	handle("GET "+pipeline, s.getPipeline)
+	handle("PATCH "+pipeline, s.updatePipeline)
+	handle("DELETE "+pipeline, s.deletePipeline)
+	handle("POST "+pipeline+"/archive", s.archivePipeline(true))
+	handle("POST "+pipeline+"/unarchive", s.archivePipeline(false))
+
+	handle("GET /v2/builds", s.listBuilds)
+	handle("GET "+org+"/builds", s.listBuilds)
+	handle("GET "+builds, s.listBuilds)
+	handle("POST "+builds, s.createBuild)
+	handle("GET "+build, s.getBuild)
+	handle("PUT "+build+"/cancel", s.cancelBuild)
+	handle("PUT "+build+"/rebuild", s.rebuildBuild)
+
+	handle("GET "+build+"/jobs", s.listJobs)
+	handle("GET "+job, s.getJob)
+	handle("GET "+org+"/jobs/{job}", s.getJob)
+	handle("PUT "+job+"/retry", s.retryJob)
+	handle("PUT "+job+"/unblock", s.unblockJob)
+
+	handle("GET "+org+"/agents", s.listAgents)
+	handle("POST "+org+"/agents", s.createAgent)
+	handle("GET "+agent, s.getAgent)
+	handle("DELETE "+agent, s.deleteAgent)
+	handle("PUT "+agent+"/stop", s.stopAgent)
+	handle("PUT "+agent+"/pause", s.pauseAgent(true))
+	handle("PUT "+agent+"/resume", s.pauseAgent(false))
+
+	handle("GET "+org+"/clusters", s.listClusters)
+	handle("POST "+org+"/clusters", s.createCluster)
+	handle("GET "+cluster, s.getCluster)
+	handle("PATCH "+cluster, s.updateCluster)
+	handle("DELETE "+cluster, s.deleteCluster)
+
+	handle("GET "+cluster+"/queues", s.listQueues)
+	handle("POST "+cluster+"/queues", s.createQueue)
+	handle("GET "+queue, s.getQueue)
+	handle("PATCH "+queue, s.updateQueue)
+	handle("DELETE "+queue, s.deleteQueue)
+	handle("POST "+queue+"/pause_dispatch", s.pauseQueue(true))
+	handle("POST "+queue+"/resume_dispatch", s.pauseQueue(false))
+
+	handle("GET "+cluster+"/secrets", s.listSecrets)
+	handle("POST "+cluster+"/secrets", s.createSecret)
+	handle("GET "+secret, s.getSecret)
+	handle("PUT "+secret, s.updateSecret)
+	handle("PUT "+secret+"/value", s.updateSecretValue)
+	handle("DELETE "+secret, s.deleteSecret)
+
+	handle("GET "+org+"/teams", s.listTeams)
+	handle("POST "+org+"/teams", s.createTeam)
+	handle("GET "+team, s.getTeam)
+	handle("PATCH "+team, s.updateTeam)
+	handle("DELETE "+team, s.deleteTeam)
+
+	handle("GET "+pipeline+"/schedules", s.listSchedules)
+	handle("POST "+pipeline+"/schedules", s.createSchedule)
+	handle("GET "+schedule, s.getSchedule)
+	handle("PATCH "+schedule, s.updateSchedule)
+	handle("DELETE "+schedule, s.deleteSchedule)
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { writeNotFound(w) })
+}
+
+// The handlers below are called by ServeHTTP with s.mu held.
+
+func (s *Server) requestOrg(w http.ResponseWriter, r *http.Request) *org {
+	o := s.orgLocked(r.PathValue("org"), false)
+	if o == nil {
+		writeNotFound(w)
+	}
+	return o
+}
+
+func (s *Server) requestPipeline(w http.ResponseWriter, r *http.Request) *pipeline {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return nil
+	}
+	p := o.pipeline(r.PathValue("pipeline"))
+	if p == nil {
+		writeNotFound(w)
+	}
+	return p
+}
+
+func (s *Server) requestBuild(w http.ResponseWriter, r *http.Request) *build {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return nil
+	}
+	number, err := strconv.Atoi(r.PathValue("number"))
+	if err != nil {
+		writeNotFound(w)
+		return nil
+	}
+	b := p.build(number)
+	if b == nil {
+		writeNotFound(w)
+	}
+	return b
+}
+
+func (s *Server) requestCluster(w http.ResponseWriter, r *http.Request) *cluster {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return nil
+	}
+	c := o.cluster(r.PathValue("cluster"))
+	if c == nil {
+		writeNotFound(w)
+	}
+	return c
+}
+
+// find returns a pointer to the item in items with the given ID, answering
+// 404 if there is none.
+func find[T any](w http.ResponseWriter, items []T, id string, idOf func(T) string) *T {
+	i := slices.IndexFunc(items, func(item T) bool { return idOf(item) == id })
+	if i < 0 {
+		writeNotFound(w)
+		return nil
+	}
+	return &items[i]
+}
+
+// remove deletes the item with the given ID from *items, answering 204, or
+// 404 if there is none.
+func remove[T any](w http.ResponseWriter, items *[]T, id string, idOf func(T) string) {
+	i := slices.IndexFunc(*items, func(item T) bool { return idOf(item) == id })
+	if i < 0 {
+		writeNotFound(w)
+		return
+	}
+	*items = slices.Delete(*items, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func filter[T any](items []T, keep func(T) bool) []T {
+	out := []T{}
+	for _, item := range items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Organizations
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request) {
+	orgs := make([]buildkite.Organization, 0, len(s.orgs))
+	for _, o := range s.orgs {
+		orgs = append(orgs, o.org)
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, orgs))
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	if o := s.requestOrg(w, r); o != nil {
+		writeJSON(w, http.StatusOK, o.org)
+	}
+}
+
+// Pipelines
+
+func (s *Server) listPipelines(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	q := r.URL.Query()
+	pipelines := []buildkite.Pipeline{}
+	for _, p := range o.pipelines {
+		if name := q.Get("name"); name != "" && !strings.Contains(strings.ToLower(p.pipeline.Name), strings.ToLower(name)) {
+			continue
+		}
+		if repo := q.Get("repository"); repo != "" && !strings.Contains(p.pipeline.Repository, repo) {
+			continue
+		}
+		pipelines = append(pipelines, p.pipeline)
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, pipelines))
+}
+
+func (s *Server) createPipeline(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	var p buildkite.Pipeline
+	if _, ok := decodeBody(w, r, &p); !ok {
+		return
+	}
+	if p.Name == "" {
+		writeMissing(w, "name")
+		return
+	}
+	p.Slug = slugify(p.Name)
+	if o.pipeline(p.Slug) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed", buildkite.FieldError{
+			Field: "name", Code: "already_exists", Message: "Name has already been taken",
+		})
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.addPipelineLocked(o, p).pipeline)
+}
+
+func (s *Server) getPipeline(w http.ResponseWriter, r *http.Request) {
+	if p := s.requestPipeline(w, r); p != nil {
+		writeJSON(w, http.StatusOK, p.pipeline)
+	}
+}
+
+func (s *Server) updatePipeline(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	updated := p.pipeline
+	if _, ok := decodeBody(w, r, &updated); !ok {
+		return
+	}
+	// Renaming a pipeline doesn't change its slug.
+	updated.Slug = p.pipeline.Slug
+	p.pipeline = updated
+	writeJSON(w, http.StatusOK, p.pipeline)
+}
+
+func (s *Server) deletePipeline(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	remove(w, &o.pipelines, r.PathValue("pipeline"), func(p *pipeline) string { return p.pipeline.Slug })
+}
+
+func (s *Server) archivePipeline(archive bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		p := s.requestPipeline(w, r)
+		if p == nil {
+			return
+		}
+		p.pipeline.ArchivedAt = nil
+		if archive {
+			p.pipeline.ArchivedAt = now()
+		}
+		writeJSON(w, http.StatusOK, p.pipeline)
+	}
+}
+
+// Builds
+
+func (s *Server) listBuilds(w http.ResponseWriter, r *http.Request) {
+	var orgs []*org
+	if slug := r.PathValue("org"); slug != "" {
+		o := s.requestOrg(w, r)
+		if o == nil {
+			return
+		}
+		orgs = []*org{o}
+	} else {
+		orgs = s.orgs
+	}
+
+	var all []*build
+	for _, o := range orgs {
+		for _, p := range o.pipelines {
+			if slug := r.PathValue("pipeline"); slug != "" && p.pipeline.Slug != slug {
+				continue
+			}
+			all = append(all, p.builds...)
+		}
+	}
+	if r.PathValue("pipeline") != "" && len(orgs) == 1 && orgs[0].pipeline(r.PathValue("pipeline")) == nil {
+		writeNotFound(w)
+		return
+	}
+
+	// Newest first, as the API lists them.
+	slices.SortFunc(all, func(a, b *build) int { return cmp.Compare(b.seq, a.seq) })
+
+	q := r.URL.Query()
+	states := buildStateFilter(q["state[]"])
+	builds := []buildkite.Build{}
+	for _, b := range all {
+		if len(states) > 0 && !slices.Contains(states, b.build.State) {
+			continue
+		}
+		if branches := q["branch[]"]; len(branches) > 0 && !slices.Contains(branches, b.build.Branch) {
+			continue
+		}
+		if commit := q.Get("commit"); commit != "" && b.build.Commit != commit {
+			continue
+		}
+		if creator := q.Get("creator"); creator != "" && b.build.Creator.ID != creator {
+			continue
+		}
+		builds = append(builds, renderBuild(r, b.build))
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, builds))
+}
+
+// buildStateFilter returns the build states matched by a state[] filter,
+// expanding "finished" to the states it stands for, as the API does.
+func buildStateFilter(values []string) []buildkite.BuildState {
+	var states []buildkite.BuildState
+	for _, v := range values {
+		if buildkite.BuildState(v) == buildkite.BuildStateFinished {
+			states = append(states, buildkite.BuildStatePassed, buildkite.BuildStateFailed, buildkite.BuildStateBlocked, buildkite.BuildStateCanceled)
+			continue
+		}
+		states = append(states, buildkite.BuildState(v))
+	}
+	return states
+}
+
+// renderBuild shapes b for a response according to the request's job and
+// pipeline exclusion parameters.
+func renderBuild(r *http.Request, b buildkite.Build) buildkite.Build {
+	q := r.URL.Query()
+	if q.Get("exclude_pipeline") == "true" {
+		b.Pipeline = nil
+	}
+	switch {
+	case q.Get("exclude_jobs") == "true":
+		b.Jobs = nil
+	case q.Get("include_retried_jobs") != "true":
+		b.Jobs = filter(b.Jobs, func(j buildkite.Job) bool { return !j.Retried })
+	default:
+		b.Jobs = slices.Clone(b.Jobs)
+	}
+	if states := q["job_states[]"]; len(states) > 0 {
+		b.Jobs = filter(b.Jobs, func(j buildkite.Job) bool { return slices.Contains(states, string(j.State)) })
+	}
+	return b
+}
+
+func (s *Server) createBuild(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	var cb buildkite.CreateBuild
+	if err := json.NewDecoder(r.Body).Decode(&cb); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+	if cb.Commit == "" {
+		writeMissing(w, "commit")
+		return
+	}
+	if cb.Branch == "" {
+		writeMissing(w, "branch")
+		return
+	}
+
+	env := map[string]any{}
+	for k, v := range cb.Env {
+		env[k] = v
+	}
+	b := buildkite.Build{
+		Commit:   cb.Commit,
+		Branch:   cb.Branch,
+		Message:  cb.Message,
+		Author:   cb.Author,
+		Env:      env,
+		MetaData: cb.MetaData,
+		Source:   "api",
+		Jobs:     jobsForSteps(p.pipeline.Steps),
+	}
+	if cb.PullRequestID != 0 {
+		b.PullRequest = &buildkite.PullRequest{
+			ID:         strconv.FormatInt(cb.PullRequestID, 10),
+			Base:       cb.PullRequestBaseBranch,
+			Repository: cb.PullRequestRepository,
+		}
+	}
+	writeJSON(w, http.StatusCreated, renderBuild(r, s.addBuildLocked(p, b).build))
+}
+
+// jobsForSteps returns a scheduled job for each of a pipeline's steps.
+func jobsForSteps(steps []buildkite.Step) []buildkite.Job {
+	var jobs []buildkite.Job
+	for _, step := range steps {
+		j := buildkite.Job{
+			Type:    buildkite.JobType(step.Type),
+			Name:    cmp.Or(step.Name, step.Label),
+			Label:   cmp.Or(step.Label, step.Name),
+			Command: step.Command,
+		}
+		switch j.Type {
+		case buildkite.JobTypeManual, "block", "input":
+			j.Type, j.State, j.Unblockable = buildkite.JobTypeManual, buildkite.JobStateBlocked, true
+		case buildkite.JobTypeWaiter, "wait":
+			j.Type = buildkite.JobTypeWaiter
+		case buildkite.JobTypeTrigger:
+		default:
+			j.Type = buildkite.JobTypeScript
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
+
+func (s *Server) getBuild(w http.ResponseWriter, r *http.Request) {
+	if b := s.requestBuild(w, r); b != nil {
+		writeJSON(w, http.StatusOK, renderBuild(r, b.build))
+	}
+}
+
+func (s *Server) cancelBuild(w http.ResponseWriter, r *http.Request) {
+	b := s.requestBuild(w, r)
+	if b == nil {
+		return
+	}
+	// A blocked build has stopped at a block step but can still be canceled,
+	// though IsTerminal counts it as finished.
+	if b.build.State.IsTerminal() && b.build.State != buildkite.BuildStateBlocked {
+		writeError(w, http.StatusUnprocessableEntity, "Build can't be canceled because it's already finished")
+		return
+	}
+	b.build.State = buildkite.BuildStateCanceled
+	b.build.FinishedAt = now()
+	for i := range b.build.Jobs {
+		if j := &b.build.Jobs[i]; !j.State.IsTerminal() {
+			j.State = buildkite.JobStateCanceled
+			j.FinishedAt = b.build.FinishedAt
+		}
+	}
+	writeJSON(w, http.StatusOK, renderBuild(r, b.build))
+}
+
+func (s *Server) rebuildBuild(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	b := s.requestBuild(w, r)
+	if b == nil {
+		return
+	}
+	rebuilt := buildkite.Build{
+		Message:  b.build.Message,
+		Commit:   b.build.Commit,
+		Branch:   b.build.Branch,
+		Author:   b.build.Author,
+		Env:      b.build.Env,
+		MetaData: b.build.MetaData,
+		Source:   "api",
+		RebuiltFrom: &buildkite.RebuiltFrom{
+			ID:     b.build.ID,
+			Number: b.build.Number,
+			URL:    b.build.URL,
+		},
+	}
+	for _, j := range b.build.Jobs {
+		if j.Retried {
+			continue
+		}
+		job := buildkite.Job{
+			Type:     j.Type,
+			Name:     j.Name,
+			Label:    j.Label,
+			StepKey:  j.StepKey,
+			GroupKey: j.GroupKey,
+			Command:  j.Command,
+		}
+		if j.Type == buildkite.JobTypeManual {
+			job.State, job.Unblockable = buildkite.JobStateBlocked, true
+		}
+		rebuilt.Jobs = append(rebuilt.Jobs, job)
+	}
+	writeJSON(w, http.StatusOK, renderBuild(r, s.addBuildLocked(p, rebuilt).build))
+}
+
+// Jobs
+
+// listJobs serves the cursor-paginated job list. The cursors are job IDs:
+// after lists the jobs following a job, and before those preceding it.
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	b := s.requestBuild(w, r)
+	if b == nil {
+		return
+	}
+
+	q := r.URL.Query()
+	jobs := filter(b.build.Jobs, func(j buildkite.Job) bool {
+		if q.Get("include_retried_jobs") != "true" && j.Retried {
+			return false
+		}
+		if states := q["state[]"]; len(states) > 0 && !slices.Contains(states, string(j.State)) {
+			return false
+		}
+		if key := q.Get("step_key"); key != "" && j.StepKey != key {
+			return false
+		}
+		if key := q.Get("group_key"); key != "" && j.GroupKey != key {
+			return false
+		}
+		return true
+	})
+
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	perPage = min(perPage, maxPerPage)
+
+	indexOf := func(id string) int {
+		return slices.IndexFunc(jobs, func(j buildkite.Job) bool { return j.ID == id })
+	}
+	start, end := 0, min(perPage, len(jobs))
+	switch {
+	case q.Get("after") != "":
+		start = indexOf(q.Get("after")) + 1
+		end = min(start+perPage, len(jobs))
+	case q.Get("before") != "":
+		end = max(indexOf(q.Get("before")), 0)
+		start = max(end-perPage, 0)
+	}
+	page := append([]buildkite.Job{}, jobs[start:end]...)
+
+	link := func(cursor, id string) buildkite.JobsListLink {
+		u := *r.URL
+		q := u.Query()
+		q.Del("after")
+		q.Del("before")
+		if cursor != "" {
+			q.Set(cursor, id)
+		}
+		u.RawQuery = q.Encode()
+		return buildkite.JobsListLink(fmt.Sprintf("http://%s%s", r.Host, u.RequestURI()))
+	}
+	list := buildkite.JobsList{Items: page, Links: buildkite.JobsListLinks{
+		First: link("", ""),
+		Self:  buildkite.JobsListLink(fmt.Sprintf("http://%s%s", r.Host, r.URL.RequestURI())),
+	}}
+	if start > 0 && len(page) > 0 {
+		list.Links.Previous = link("before", page[0].ID)
+	}
+	if end < len(jobs) && len(page) > 0 {
+		list.Links.Next = link("after", page[len(page)-1].ID)
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// requestJob returns the job named by the request, and its build, answering
+// 404 if either doesn't exist. Jobs looked up by organization alone are
+// searched for across all of its builds.
+func (s *Server) requestJob(w http.ResponseWriter, r *http.Request) (*build, *buildkite.Job) {
+	id := r.PathValue("job")
+	if r.PathValue("pipeline") != "" {
+		b := s.requestBuild(w, r)
+		if b == nil {
+			return nil, nil
+		}
+		j := find(w, b.build.Jobs, id, func(j buildkite.Job) string { return j.ID })
+		return b, j
+	}
+
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return nil, nil
+	}
+	for _, p := range o.pipelines {
+		for _, b := range p.builds {
+			for i := range b.build.Jobs {
+				if b.build.Jobs[i].ID == id {
+					return b, &b.build.Jobs[i]
+				}
+			}
+		}
+	}
+	writeNotFound(w)
+	return nil, nil
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	if _, j := s.requestJob(w, r); j != nil {
+		writeJSON(w, http.StatusOK, j)
+	}
+}
+
+func (s *Server) retryJob(w http.ResponseWriter, r *http.Request) {
+	b, j := s.requestJob(w, r)
+	if j == nil {
+		return
+	}
+	if j.Retried || j.Type != buildkite.JobTypeScript || !j.State.IsTerminal() || j.State == buildkite.JobStatePassed {
+		writeError(w, http.StatusUnprocessableEntity, "Only failed, timed out or canceled jobs can be retried")
+		return
+	}
+
+	retry := buildkite.Job{
+		Type:         j.Type,
+		Name:         j.Name,
+		Label:        j.Label,
+		StepKey:      j.StepKey,
+		GroupKey:     j.GroupKey,
+		Command:      j.Command,
+		RetriesCount: j.RetriesCount + 1,
+		RetryType:    "manual",
+	}
+	s.fillJobLocked(&b.build, &retry)
+	j.Retried = true
+	j.RetriedInJobID = retry.ID
+	b.build.Jobs = append(b.build.Jobs, retry)
+
+	b.build.State = buildkite.BuildStateRunning
+	b.build.FinishedAt = nil
+	writeJSON(w, http.StatusOK, retry)
+}
+
+func (s *Server) unblockJob(w http.ResponseWriter, r *http.Request) {
+	b, j := s.requestJob(w, r)
+	if j == nil {
+		return
+	}
+	if j.Type != buildkite.JobTypeManual || j.State != buildkite.JobStateBlocked {
+		writeError(w, http.StatusUnprocessableEntity, "This job can't be unblocked")
+		return
+	}
+	j.State = buildkite.JobStateUnblocked
+	j.Unblockable = false
+	j.UnblockedAt = now()
+	b.build.Blocked = slices.ContainsFunc(b.build.Jobs, func(j buildkite.Job) bool { return j.State == buildkite.JobStateBlocked })
+	writeJSON(w, http.StatusOK, j)
+}
+
+// Agents
+
+func agentID(a buildkite.Agent) string { return a.ID }
+
+func (s *Server) listAgents(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	q := r.URL.Query()
+	agents := filter(o.agents, func(a buildkite.Agent) bool {
+		return (q.Get("name") == "" || strings.Contains(a.Name, q.Get("name"))) &&
+			(q.Get("hostname") == "" || a.Hostname == q.Get("hostname")) &&
+			(q.Get("version") == "" || a.Version == q.Get("version"))
+	})
+	writeJSON(w, http.StatusOK, paginate(w, r, agents))
+}
+
+func (s *Server) createAgent(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	var a buildkite.Agent
+	if _, ok := decodeBody(w, r, &a); !ok {
+		return
+	}
+	a.ID = ""
+	writeJSON(w, http.StatusCreated, s.addAgentLocked(o, a))
+}
+
+func (s *Server) getAgent(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	if a := find(w, o.agents, r.PathValue("agent"), agentID); a != nil {
+		writeJSON(w, http.StatusOK, a)
+	}
+}
+
+func (s *Server) deleteAgent(w http.ResponseWriter, r *http.Request) {
+	if o := s.requestOrg(w, r); o != nil {
+		remove(w, &o.agents, r.PathValue("agent"), agentID)
+	}
+}
+
+func (s *Server) stopAgent(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	a := find(w, o.agents, r.PathValue("agent"), agentID)
+	if a == nil {
+		return
+	}
+	if a.ConnectedState != "connected" {
+		writeError(w, http.StatusUnprocessableEntity, "Agent is not connected")
+		return
+	}
+	a.ConnectedState = "stopped"
+	a.StoppedAt = now()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) pauseAgent(pause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o := s.requestOrg(w, r)
+		if o == nil {
+			return
+		}
+		a := find(w, o.agents, r.PathValue("agent"), agentID)
+		if a == nil {
+			return
+		}
+		var opts buildkite.AgentPauseOptions
+		if _, ok := decodeBody(w, r, &opts); !ok {
+			return
+		}
+		a.Paused = &pause
+		a.PausedAt, a.PausedNote, a.PausedTimeoutInMinutes = nil, nil, nil
+		if pause {
+			a.PausedAt = now()
+			if opts.Note != "" {
+				a.PausedNote = &opts.Note
+			}
+			if opts.TimeoutInMinutes != 0 {
+				a.PausedTimeoutInMinutes = &opts.TimeoutInMinutes
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Clusters
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	clusters := make([]buildkite.Cluster, 0, len(o.clusters))
+	for _, c := range o.clusters {
+		clusters = append(clusters, c.cluster)
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, clusters))
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	var c buildkite.Cluster
+	if _, ok := decodeBody(w, r, &c); !ok {
+		return
+	}
+	if c.Name == "" {
+		writeMissing(w, "name")
+		return
+	}
+	c.ID = ""
+	writeJSON(w, http.StatusCreated, s.addClusterLocked(o, c).cluster)
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	if c := s.requestCluster(w, r); c != nil {
+		writeJSON(w, http.StatusOK, c.cluster)
+	}
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	updated := c.cluster
+	if _, ok := decodeBody(w, r, &updated); !ok {
+		return
+	}
+	updated.ID = c.cluster.ID
+	c.cluster = updated
+	writeJSON(w, http.StatusOK, c.cluster)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	if o := s.requestOrg(w, r); o != nil {
+		remove(w, &o.clusters, r.PathValue("cluster"), func(c *cluster) string { return c.cluster.ID })
+	}
+}
+
+// Cluster queues
+
+func queueID(q buildkite.ClusterQueue) string { return q.ID }
+
+func (s *Server) listQueues(w http.ResponseWriter, r *http.Request) {
+	if c := s.requestCluster(w, r); c != nil {
+		writeJSON(w, http.StatusOK, paginate(w, r, c.queues))
+	}
+}
+
+func (s *Server) createQueue(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	var q buildkite.ClusterQueue
+	if _, ok := decodeBody(w, r, &q); !ok {
+		return
+	}
+	if q.Key == "" {
+		writeMissing(w, "key")
+		return
+	}
+	q.ID = ""
+	writeJSON(w, http.StatusCreated, s.addQueueLocked(c, q))
+}
+
+func (s *Server) getQueue(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	if q := find(w, c.queues, r.PathValue("queue"), queueID); q != nil {
+		writeJSON(w, http.StatusOK, q)
+	}
+}
+
+func (s *Server) updateQueue(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	q := find(w, c.queues, r.PathValue("queue"), queueID)
+	if q == nil {
+		return
+	}
+	updated := *q
+	if _, ok := decodeBody(w, r, &updated); !ok {
+		return
+	}
+	updated.ID, updated.Key = q.ID, q.Key
+	*q = updated
+	writeJSON(w, http.StatusOK, q)
+}
+
+func (s *Server) deleteQueue(w http.ResponseWriter, r *http.Request) {
+	if c := s.requestCluster(w, r); c != nil {
+		remove(w, &c.queues, r.PathValue("queue"), queueID)
+	}
+}
+
+func (s *Server) pauseQueue(pause bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := s.requestCluster(w, r)
+		if c == nil {
+			return
+		}
+		q := find(w, c.queues, r.PathValue("queue"), queueID)
+		if q == nil {
+			return
+		}
+		var qp buildkite.ClusterQueuePause
+		if _, ok := decodeBody(w, r, &qp); !ok {
+			return
+		}
+		q.DispatchPaused = pause
+		q.DispatchPausedAt, q.DispatchPausedNote = nil, ""
+		if pause {
+			q.DispatchPausedAt = now()
+			q.DispatchPausedNote = qp.Note
+		}
+		writeJSON(w, http.StatusOK, q)
+	}
+}
+
+// Cluster secrets
+
+func secretID(sec secret) string { return sec.secret.ID }
+
+func (s *Server) listSecrets(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	secrets := make([]buildkite.ClusterSecret, 0, len(c.secrets))
+	for _, sec := range c.secrets {
+		secrets = append(secrets, sec.secret)
+	}
+	writeJSON(w, http.StatusOK, paginate(w, r, secrets))
+}
+
+func (s *Server) createSecret(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	var in buildkite.ClusterSecretCreate
+	if _, ok := decodeBody(w, r, &in); !ok {
+		return
+	}
+	if in.Key == "" {
+		writeMissing(w, "key")
+		return
+	}
+	if slices.ContainsFunc(c.secrets, func(sec secret) bool { return sec.secret.Key == in.Key }) {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed", buildkite.FieldError{
+			Field: "key", Code: "already_exists", Message: "Key has already been taken",
+		})
+		return
+	}
+	sec := buildkite.ClusterSecret{Key: in.Key, Description: in.Description, Policy: in.Policy}
+	writeJSON(w, http.StatusCreated, s.addSecretLocked(s.orgLocked(r.PathValue("org"), false), c, sec, in.Value))
+}
+
+func (s *Server) getSecret(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	if sec := find(w, c.secrets, r.PathValue("secret"), secretID); sec != nil {
+		writeJSON(w, http.StatusOK, sec.secret)
+	}
+}
+
+func (s *Server) updateSecret(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	sec := find(w, c.secrets, r.PathValue("secret"), secretID)
+	if sec == nil {
+		return
+	}
+	updated := sec.secret
+	if _, ok := decodeBody(w, r, &updated); !ok {
+		return
+	}
+	updated.ID, updated.Key = sec.secret.ID, sec.secret.Key
+	updated.UpdatedAt = now()
+	sec.secret = updated
+	writeJSON(w, http.StatusOK, sec.secret)
+}
+
+func (s *Server) updateSecretValue(w http.ResponseWriter, r *http.Request) {
+	c := s.requestCluster(w, r)
+	if c == nil {
+		return
+	}
+	sec := find(w, c.secrets, r.PathValue("secret"), secretID)
+	if sec == nil {
+		return
+	}
+	var in buildkite.ClusterSecretValueUpdate
+	if _, ok := decodeBody(w, r, &in); !ok {
+		return
+	}
+	sec.value = in.Value
+	sec.secret.UpdatedAt = now()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSecret(w http.ResponseWriter, r *http.Request) {
+	if c := s.requestCluster(w, r); c != nil {
+		remove(w, &c.secrets, r.PathValue("secret"), secretID)
+	}
+}
+
+// Teams
+
+func teamID(t buildkite.Team) string { return t.ID }
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	if o := s.requestOrg(w, r); o != nil {
+		writeJSON(w, http.StatusOK, paginate(w, r, o.teams))
+	}
+}
+
+// isDefaultTeam returns the is_default_team field of a team create or update
+// request body, which a Team calls "default", or current if it is absent.
+func isDefaultTeam(body []byte, current bool) bool {
+	var in struct {
+		IsDefaultTeam *bool `json:"is_default_team"`
+	}
+	if err := json.Unmarshal(body, &in); err != nil || in.IsDefaultTeam == nil {
+		return current
+	}
+	return *in.IsDefaultTeam
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	var t buildkite.Team
+	body, ok := decodeBody(w, r, &t)
+	if !ok {
+		return
+	}
+	if t.Name == "" {
+		writeMissing(w, "name")
+		return
+	}
+	t.Default = isDefaultTeam(body, t.Default)
+	t.ID, t.Slug = "", ""
+	writeJSON(w, http.StatusCreated, s.addTeamLocked(o, t))
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	if t := find(w, o.teams, r.PathValue("team"), teamID); t != nil {
+		writeJSON(w, http.StatusOK, t)
+	}
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	o := s.requestOrg(w, r)
+	if o == nil {
+		return
+	}
+	t := find(w, o.teams, r.PathValue("team"), teamID)
+	if t == nil {
+		return
+	}
+	updated := *t
+	body, ok := decodeBody(w, r, &updated)
+	if !ok {
+		return
+	}
+	updated.Default = isDefaultTeam(body, updated.Default)
+	updated.ID, updated.Slug = t.ID, t.Slug
+	*t = updated
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	if o := s.requestOrg(w, r); o != nil {
+		remove(w, &o.teams, r.PathValue("team"), teamID)
+	}
+}
+
+// Pipeline schedules
+
+func scheduleID(ps buildkite.PipelineSchedule) string { return ps.ID }
+
+func (s *Server) listSchedules(w http.ResponseWriter, r *http.Request) {
+	if p := s.requestPipeline(w, r); p != nil {
+		writeJSON(w, http.StatusOK, paginate(w, r, p.schedules))
+	}
+}
+
+func (s *Server) createSchedule(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	// Schedules are enabled unless the request says otherwise.
+	ps := buildkite.PipelineSchedule{Enabled: true}
+	if _, ok := decodeBody(w, r, &ps); !ok {
+		return
+	}
+	if ps.Cronline == "" {
+		writeMissing(w, "cronline")
+		return
+	}
+	ps.ID = ""
+	writeJSON(w, http.StatusCreated, s.addScheduleLocked(p, ps))
+}
+
+func (s *Server) getSchedule(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	if ps := find(w, p.schedules, r.PathValue("schedule"), scheduleID); ps != nil {
+		writeJSON(w, http.StatusOK, ps)
+	}
+}
+
+func (s *Server) updateSchedule(w http.ResponseWriter, r *http.Request) {
+	p := s.requestPipeline(w, r)
+	if p == nil {
+		return
+	}
+	ps := find(w, p.schedules, r.PathValue("schedule"), scheduleID)
+	if ps == nil {
+		return
+	}
+	updated := *ps
+	if _, ok := decodeBody(w, r, &updated); !ok {
+		return
+	}
+	updated.ID = ps.ID
+	*ps = updated
+	writeJSON(w, http.StatusOK, ps)
+}
+
+func (s *Server) deleteSchedule(w http.ResponseWriter, r *http.Request) {
+	if p := s.requestPipeline(w, r); p != nil {
+		remove(w, &p.schedules, r.PathValue("schedule"), scheduleID)
+	}
+}
@@ -0,0 +1,361 @@
+// Package buildkitetest provides an in-memory fake of the Buildkite REST API
+// for testing code built on the buildkite package.
+//
+// A Server keeps organizations, pipelines, builds, jobs, agents, clusters,
+// cluster queues and secrets, teams and pipeline schedules in memory and
+// serves the REST routes the buildkite.Client calls against them. Tests seed
+// it with the Add methods, move builds and jobs through their lifecycle with
+// UpdateBuild and UpdateJob, and inject rate limiting, server errors and
+// latency with AddFault:
+//
+//	srv := buildkitetest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddPipeline("acme", buildkite.Pipeline{Name: "Web"})
+//	srv.AddFault(buildkitetest.Fault{Path: "/v2/organizations/acme/pipelines", Status: 503, Times: 1})
+//
+//	client, err := srv.Client()
+//
+// The server models the API closely enough to exercise pagination, state
+// transitions and error handling, but it does not run jobs, and routes it
+// does not implement answer 404.
+package buildkitetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+const (
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// Request is a request received by a Server, as returned by Server.Requests.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is an in-memory fake of the Buildkite REST API. It is safe for
+// concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for use with buildkite.WithBaseURL.
+	URL string
+
+	srv   *httptest.Server
+	mux   *http.ServeMux
+	token string
+
+	mu       sync.Mutex
+	orgs     []*org
+	ids      int
+	seq      int
+	faults   []*fault
+	rate     *rateLimit
+	requests []Request
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithToken makes the server reject requests that don't carry token as a
+// bearer token with 401 Unauthorized.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithRateLimit gives the server a budget of limit requests per window.
+// Every response reports the budget in RateLimit-Limit, RateLimit-Remaining
+// and RateLimit-Reset headers, as the API does, and requests beyond it are
+// answered 429 Too Many Requests until the window resets.
+func WithRateLimit(limit int, window time.Duration) Option {
+	return func(s *Server) {
+		s.rate = &rateLimit{limit: limit, window: window}
+	}
+}
+
+// NewServer starts and returns a new Server with no data. The caller should
+// call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{mux: http.NewServeMux()}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes()
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests on
+// it have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a buildkite.Client that talks to the server, authenticated
+// with the server's token if it has one. opts are applied after those.
+func (s *Server) Client(opts ...buildkite.ClientOpt) (*buildkite.Client, error) {
+	token := s.token
+	if token == "" {
+		token = "buildkitetest"
+	}
+	return buildkite.NewOpts(append([]buildkite.ClientOpt{
+		buildkite.WithBaseURL(s.URL),
+		buildkite.WithTokenAuth(token),
+	}, opts...)...)
+}
+
+// Requests returns the requests the server has received, in order, including
+// those answered by an injected fault.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ServeHTTP records the request, applies authentication, any matching fault
+// and the rate limit, and then routes it to the fake API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Couldn't read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	s.mu.Unlock()
+
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		writeError(w, http.StatusUnauthorized, "Authentication required. Please supply a valid API Access Token")
+		return
+	}
+
+	s.mu.Lock()
+	f := s.takeFaultLocked(r)
+	s.mu.Unlock()
+
+	if f != nil {
+		if f.Latency > 0 {
+			timer := time.NewTimer(f.Latency)
+			select {
+			case <-timer.C:
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			}
+		}
+		if f.Status != 0 {
+			f.write(w)
+			return
+		}
+	}
+
+	if s.rate != nil {
+		s.mu.Lock()
+		ok := s.rate.take(w.Header(), time.Now())
+		s.mu.Unlock()
+		if !ok {
+			writeError(w, http.StatusTooManyRequests, "You have exceeded your rate limit. Please wait before trying again.")
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mux.ServeHTTP(w, r)
+}
+
+// rateLimit tracks the server's request budget for WithRateLimit.
+type rateLimit struct {
+	limit   int
+	window  time.Duration
+	used    int
+	resetAt time.Time
+}
+
+// take spends one request from the budget, reporting the budget in h, and
+// reports whether the request is within it.
+func (rl *rateLimit) take(h http.Header, now time.Time) bool {
+	if !now.Before(rl.resetAt) {
+		rl.used = 0
+		rl.resetAt = now.Add(rl.window)
+	}
+	rl.used++
+
+	h.Set("RateLimit-Limit", strconv.Itoa(rl.limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(max(rl.limit-rl.used, 0)))
+	h.Set("RateLimit-Reset", strconv.Itoa(int(math.Ceil(rl.resetAt.Sub(now).Seconds()))))
+
+	return rl.used <= rl.limit
+}
+
+// newIDLocked returns a new UUID-shaped identifier, unique within the server.
+func (s *Server) newIDLocked() string {
+	s.ids++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.ids)
+}
+
+// nextSeqLocked returns the next value of a counter used to order builds
+// across pipelines by creation.
+func (s *Server) nextSeqLocked() int {
+	s.seq++
+	return s.seq
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string, errs ...buildkite.FieldError) {
+	body := struct {
+		Message string                 `json:"message"`
+		Errors  []buildkite.FieldError `json:"errors,omitempty"`
+	}{message, errs}
+	writeJSON(w, status, body)
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// writeMissing answers 422 for a request missing a required field.
+func writeMissing(w http.ResponseWriter, field string) {
+	writeError(w, http.StatusUnprocessableEntity, "Validation Failed", buildkite.FieldError{
+		Field:   field,
+		Code:    "missing_field",
+		Message: fmt.Sprintf("%s can't be blank", field),
+	})
+}
+
+// paginate returns the requested page of items, setting a Link header for
+// the neighbouring pages in the same form as the API.
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T) []T {
+	q := r.URL.Query()
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	perPage = min(perPage, maxPerPage)
+
+	last := max((len(items)+perPage-1)/perPage, 1)
+
+	var links []string
+	link := func(p int, rel string) {
+		u := *r.URL
+		q := u.Query()
+		q.Set("page", strconv.Itoa(p))
+		u.RawQuery = q.Encode()
+		links = append(links, fmt.Sprintf(`<http://%s%s>; rel="%s"`, r.Host, u.RequestURI(), rel))
+	}
+	if page < last {
+		link(page+1, "next")
+		link(last, "last")
+	}
+	if page > 1 {
+		link(1, "first")
+		link(page-1, "prev")
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return append([]T{}, items[start:end]...)
+}
+
+// decodePatch applies the JSON object in data to *dst, so that fields present
+// in data replace those in *dst and fields absent from it are kept. Create and
+// update request bodies share their field names with the resources they
+// create or update, so this serves for both.
+func decodePatch[T any](dst *T, data []byte) error {
+	patch := map[string]json.RawMessage{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &patch); err != nil {
+			return err
+		}
+	}
+
+	current, err := json.Marshal(dst)
+	if err != nil {
+		return err
+	}
+	merged := map[string]json.RawMessage{}
+	if err := json.Unmarshal(current, &merged); err != nil {
+		return err
+	}
+	for k, v := range patch {
+		merged[k] = v
+	}
+
+	data, err = json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*dst = out
+	return nil
+}
+
+// decodeBody applies the request body to *dst with decodePatch, answering a
+// malformed body with 400. It returns the body, or false if it was malformed.
+func decodeBody[T any](w http.ResponseWriter, r *http.Request, dst *T) ([]byte, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = decodePatch(dst, data)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return nil, false
+	}
+	return data, true
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify derives a slug from a name the way Buildkite does for pipelines
+// and teams.
+func slugify(name string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func now() *buildkite.Timestamp {
+	return buildkite.NewTimestamp(time.Now().UTC())
+}
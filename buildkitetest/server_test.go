@@ -0,0 +1,314 @@
+package buildkitetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5"
+	"github.com/buildkite/go-buildkite/v5/buildkitetest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newServerAndClient(t *testing.T, opts ...buildkitetest.Option) (*buildkitetest.Server, *buildkite.Client) {
+	t.Helper()
+
+	srv := buildkitetest.NewServer(opts...)
+	t.Cleanup(srv.Close)
+
+	client, err := srv.Client(buildkite.WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("Client() error: %v", err)
+	}
+	return srv, client
+}
+
+func TestServer_Pipelines(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+	ctx := context.Background()
+
+	srv.AddOrganization(buildkite.Organization{Name: "Acme"})
+
+	created, _, err := client.Pipelines.Create(ctx, "acme", buildkite.CreatePipeline{
+		Name:       "My Web App",
+		Repository: "git@github.com:acme/web.git",
+	})
+	if err != nil {
+		t.Fatalf("Pipelines.Create() error: %v", err)
+	}
+	if created.Slug != "my-web-app" || created.ID == "" {
+		t.Errorf("Pipelines.Create() = slug %q, id %q, want slug %q and an ID", created.Slug, created.ID, "my-web-app")
+	}
+
+	updated, _, err := client.Pipelines.Update(ctx, "acme", created.Slug, buildkite.UpdatePipeline{
+		Description: buildkite.Some("The website"),
+	})
+	if err != nil {
+		t.Fatalf("Pipelines.Update() error: %v", err)
+	}
+	if updated.Description != "The website" || updated.Repository != created.Repository {
+		t.Errorf("Pipelines.Update() = description %q, repository %q; want the description changed and repository kept", updated.Description, updated.Repository)
+	}
+
+	_, _, err = client.Pipelines.Create(ctx, "acme", buildkite.CreatePipeline{Name: "My Web App"})
+	if !errors.Is(err, buildkite.ErrValidation) {
+		t.Errorf("Pipelines.Create() duplicate error = %v, want ErrValidation", err)
+	}
+
+	if _, err := client.Pipelines.Delete(ctx, "acme", created.Slug); err != nil {
+		t.Fatalf("Pipelines.Delete() error: %v", err)
+	}
+	if _, _, err := client.Pipelines.Get(ctx, "acme", created.Slug); !errors.Is(err, buildkite.ErrNotFound) {
+		t.Errorf("Pipelines.Get() after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestServer_BuildLifecycle(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+	ctx := context.Background()
+
+	srv.AddPipeline("acme", buildkite.Pipeline{
+		Name: "web",
+		Steps: []buildkite.Step{
+			{Type: "script", Label: "test", Command: "make test"},
+			{Type: "manual", Label: "deploy?"},
+		},
+	})
+
+	build, _, err := client.Builds.Create(ctx, "acme", "web", buildkite.CreateBuild{Commit: "abc123", Branch: "main"})
+	if err != nil {
+		t.Fatalf("Builds.Create() error: %v", err)
+	}
+	if build.Number != 1 || build.State != "scheduled" || len(build.Jobs) != 2 {
+		t.Fatalf("Builds.Create() = number %d, state %q, %d jobs; want 1, scheduled, 2 jobs", build.Number, build.State, len(build.Jobs))
+	}
+
+	testJob, blockJob := build.Jobs[0], build.Jobs[1]
+
+	unblocked, _, err := client.Jobs.UnblockJob(ctx, "acme", "web", "1", blockJob.ID, nil)
+	if err != nil {
+		t.Fatalf("Jobs.UnblockJob() error: %v", err)
+	}
+	if unblocked.State != "unblocked" {
+		t.Errorf("Jobs.UnblockJob() state = %q, want unblocked", unblocked.State)
+	}
+
+	srv.UpdateJob("acme", "web", 1, testJob.ID, func(j *buildkite.Job) { j.State = "failed" })
+
+	retried, _, err := client.Jobs.RetryJob(ctx, "acme", "web", "1", testJob.ID)
+	if err != nil {
+		t.Fatalf("Jobs.RetryJob() error: %v", err)
+	}
+	if retried.ID == testJob.ID || retried.RetriesCount != 1 {
+		t.Errorf("Jobs.RetryJob() = id %q, retries %d; want a new job retried once", retried.ID, retried.RetriesCount)
+	}
+
+	build, err = client.Builds.Cancel(ctx, "acme", "web", "1")
+	if err != nil {
+		t.Fatalf("Builds.Cancel() error: %v", err)
+	}
+	if build.State != "canceled" {
+		t.Errorf("Builds.Cancel() state = %q, want canceled", build.State)
+	}
+	for _, j := range build.Jobs {
+		if j.ID == blockJob.ID && j.State != "unblocked" {
+			t.Errorf("Builds.Cancel() block job state = %q, want it left unblocked", j.State)
+		}
+	}
+
+	_, err = client.Builds.Cancel(ctx, "acme", "web", "1")
+	if !errors.Is(err, buildkite.ErrValidation) {
+		t.Errorf("Builds.Cancel() of a finished build error = %v, want ErrValidation", err)
+	}
+
+	rebuilt, err := client.Builds.Rebuild(ctx, "acme", "web", "1")
+	if err != nil {
+		t.Fatalf("Builds.Rebuild() error: %v", err)
+	}
+	if rebuilt.Number != 2 || rebuilt.RebuiltFrom == nil || rebuilt.RebuiltFrom.Number != 1 {
+		t.Errorf("Builds.Rebuild() = number %d, rebuilt from %+v; want build 2 rebuilt from 1", rebuilt.Number, rebuilt.RebuiltFrom)
+	}
+}
+
+func TestServer_ListBuildsPaginates(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+
+	for i := range 5 {
+		state := buildkite.BuildStatePassed
+		if i%2 == 1 {
+			state = buildkite.BuildStateFailed
+		}
+		srv.AddBuild("acme", "web", buildkite.Build{State: state})
+	}
+
+	var numbers []int
+	opt := &buildkite.BuildsListOptions{ListOptions: buildkite.ListOptions{PerPage: 2}}
+	for b, err := range client.Builds.ListByPipelineAll(context.Background(), "acme", "web", opt) {
+		if err != nil {
+			t.Fatalf("Builds.ListByPipelineAll() error: %v", err)
+		}
+		numbers = append(numbers, b.Number)
+	}
+	if diff := cmp.Diff([]int{5, 4, 3, 2, 1}, numbers); diff != "" {
+		t.Errorf("Builds.ListByPipelineAll() numbers diff: (-want +got)\n%s", diff)
+	}
+
+	failed, _, err := client.Builds.ListByOrg(context.Background(), "acme", &buildkite.BuildsListOptions{State: []buildkite.BuildState{buildkite.BuildStateFailed}})
+	if err != nil {
+		t.Fatalf("Builds.ListByOrg() error: %v", err)
+	}
+	if len(failed) != 2 {
+		t.Errorf("Builds.ListByOrg(state=failed) returned %d builds, want 2", len(failed))
+	}
+
+	srv.AddBuild("acme", "web", buildkite.Build{State: buildkite.BuildStateRunning})
+	finished, _, err := client.Builds.ListByOrg(context.Background(), "acme", &buildkite.BuildsListOptions{State: []buildkite.BuildState{buildkite.BuildStateFinished}})
+	if err != nil {
+		t.Fatalf("Builds.ListByOrg() error: %v", err)
+	}
+	if len(finished) != 5 {
+		t.Errorf("Builds.ListByOrg(state=finished) returned %d builds, want 5", len(finished))
+	}
+}
+
+func TestServer_ListJobsCursor(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+
+	jobs := make([]buildkite.Job, 5)
+	for i := range jobs {
+		jobs[i].Label = "job " + strconv.Itoa(i)
+	}
+	srv.AddBuild("acme", "web", buildkite.Build{Jobs: jobs})
+
+	var labels []string
+	opt := &buildkite.JobsListOptions{PerPage: 2}
+	for j, err := range client.Jobs.ListByBuildAll(context.Background(), "acme", "web", "1", opt) {
+		if err != nil {
+			t.Fatalf("Jobs.ListByBuildAll() error: %v", err)
+		}
+		labels = append(labels, j.Label)
+	}
+	if diff := cmp.Diff([]string{"job 0", "job 1", "job 2", "job 3", "job 4"}, labels); diff != "" {
+		t.Errorf("Jobs.ListByBuildAll() labels diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestServer_ClusterSecrets(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+	ctx := context.Background()
+
+	cluster := srv.AddCluster("acme", buildkite.Cluster{Name: "Default"})
+
+	created, _, err := client.ClusterSecrets.Create(ctx, "acme", cluster.ID, buildkite.ClusterSecretCreate{
+		Key:   "DEPLOY_KEY",
+		Value: "hunter2",
+	})
+	if err != nil {
+		t.Fatalf("ClusterSecrets.Create() error: %v", err)
+	}
+
+	if _, err := client.ClusterSecrets.UpdateValue(ctx, "acme", cluster.ID, created.ID, buildkite.ClusterSecretValueUpdate{Value: "correct horse"}); err != nil {
+		t.Fatalf("ClusterSecrets.UpdateValue() error: %v", err)
+	}
+	if value, _ := srv.ClusterSecretValue("acme", cluster.ID, created.ID); value != "correct horse" {
+		t.Errorf("ClusterSecretValue() = %q, want %q", value, "correct horse")
+	}
+
+	got, _, err := client.ClusterSecrets.Get(ctx, "acme", cluster.ID, created.ID)
+	if err != nil {
+		t.Fatalf("ClusterSecrets.Get() error: %v", err)
+	}
+	if got.Key != "DEPLOY_KEY" || got.UpdatedAt == nil {
+		t.Errorf("ClusterSecrets.Get() = key %q, updated at %v; want DEPLOY_KEY with an update time", got.Key, got.UpdatedAt)
+	}
+}
+
+func TestServer_FaultInjection(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t)
+	ctx := context.Background()
+
+	srv.AddPipeline("acme", buildkite.Pipeline{Name: "web"})
+	srv.AddFault(buildkitetest.Fault{
+		Method: http.MethodGet,
+		Path:   "/v2/organizations/*/pipelines/*",
+		Status: http.StatusServiceUnavailable,
+		Times:  1,
+	})
+
+	_, resp, err := client.Pipelines.Get(ctx, "acme", "web")
+	if err == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Pipelines.Get() = %v, %v; want a 503 error", resp, err)
+	}
+
+	if _, _, err := client.Pipelines.Get(ctx, "acme", "web"); err != nil {
+		t.Fatalf("Pipelines.Get() after the fault error: %v", err)
+	}
+
+	srv.AddFault(buildkitetest.RateLimited(1, time.Minute))
+	_, _, err = client.Pipelines.Get(ctx, "acme", "web")
+	if !errors.Is(err, buildkite.ErrRateLimited) {
+		t.Errorf("Pipelines.Get() error = %v, want ErrRateLimited", err)
+	}
+
+	srv.AddFault(buildkitetest.Fault{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Pipelines.Get(ctx, "acme", "web"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pipelines.Get() with latency error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	_, client := newServerAndClient(t, buildkitetest.WithRateLimit(2, time.Minute))
+	ctx := context.Background()
+
+	_, resp, err := client.Organizations.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Organizations.List() error: %v", err)
+	}
+	if resp.Rate.Limit != 2 || resp.Rate.Remaining != 1 {
+		t.Errorf("Rate = %+v, want limit 2 with 1 remaining", resp.Rate)
+	}
+
+	if _, _, err := client.Organizations.List(ctx, nil); err != nil {
+		t.Fatalf("Organizations.List() error: %v", err)
+	}
+	if _, _, err := client.Organizations.List(ctx, nil); !errors.Is(err, buildkite.ErrRateLimited) {
+		t.Errorf("Organizations.List() over the limit error = %v, want ErrRateLimited", err)
+	}
+}
+
+func TestServer_Token(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newServerAndClient(t, buildkitetest.WithToken("s3cret"))
+
+	if _, _, err := client.Organizations.List(context.Background(), nil); err != nil {
+		t.Fatalf("Organizations.List() with the server's token error: %v", err)
+	}
+
+	other, err := buildkite.NewOpts(buildkite.WithBaseURL(srv.URL), buildkite.WithTokenAuth("wrong"))
+	if err != nil {
+		t.Fatalf("NewOpts() error: %v", err)
+	}
+	if _, _, err := other.Organizations.List(context.Background(), nil); !errors.Is(err, buildkite.ErrUnauthorized) {
+		t.Errorf("Organizations.List() with another token error = %v, want ErrUnauthorized", err)
+	}
+}
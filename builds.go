@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 )
@@ -111,7 +112,7 @@ type Build struct {
 	URL         string            `json:"url,omitempty"`
 	WebURL      string            `json:"web_url,omitempty"`
 	Number      int               `json:"number,omitempty"`
-	State       string            `json:"state,omitempty"`
+	State       BuildState        `json:"state,omitempty"`
 	Blocked     bool              `json:"blocked"`
 	Message     string            `json:"message,omitempty"`
 	Commit      string            `json:"commit,omitempty"`
@@ -178,9 +179,9 @@ type BuildsListOptions struct {
 	// Filters the results by builds finished on or after the given time
 	FinishedFrom time.Time `url:"finished_from,omitempty"`
 
-	// State of builds to list.  Possible values are: running, scheduled, passed,
-	// failed, canceled, skipped and not_run. Default is "".
-	State []string `url:"state,brackets,omitempty"`
+	// State of builds to list, such as BuildStateRunning, or
+	// BuildStateFinished for builds that have finished. Default is all states.
+	State []BuildState `url:"state,brackets,omitempty"`
 
 	// Filters the results by branch name(s)
 	Branch []string `url:"branch,brackets,omitempty"`
@@ -219,28 +220,34 @@ type BuildGetOptions struct {
 	// Include Test Engine data
 	IncludeTestEngine bool `url:"include_test_engine,omitempty"`
 
-	// Filters jobs in the response by state. Possible values are: running, scheduled, passed,
-	// failed, canceled, skipped, not_run, broken. Default is "".
-	JobStates []string `url:"job_states,brackets,omitempty"`
+	// Filters jobs in the response by state, such as JobStateFailed. Default
+	// is all states.
+	JobStates []JobState `url:"job_states,brackets,omitempty"`
 }
 
 // Cancel - Trigger a cancel for the target build
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/builds#cancel-a-build
 func (bs *BuildsService) Cancel(ctx context.Context, org, pipeline, buildNumber string) (Build, error) {
+	build, _, err := bs.cancel(ctx, org, pipeline, buildNumber)
+	return build, err
+}
+
+// cancel is Cancel, also returning the Response.
+func (bs *BuildsService) cancel(ctx context.Context, org, pipeline, buildNumber string) (Build, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/pipelines/%s/builds/%s/cancel", org, pipeline, buildNumber)
 	req, err := bs.client.NewRequest(ctx, "PUT", u, nil)
 	if err != nil {
-		return Build{}, err
+		return Build{}, nil, err
 	}
 
 	var result Build
-	_, err = bs.client.Do(req, &result)
+	resp, err := bs.client.Do(req, &result)
 	if err != nil {
-		return Build{}, err
+		return Build{}, resp, err
 	}
 
-	return result, nil
+	return result, resp, nil
 }
 
 // Create - Create a pipeline
@@ -311,6 +318,14 @@ func (bs *BuildsService) List(ctx context.Context, opt *BuildsListOptions) ([]Bu
 	return builds, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (bs *BuildsService) ListAll(ctx context.Context, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error) {
+		return bs.List(ctx, opt)
+	})
+}
+
 // ListByOrg lists the builds within the specified orginisation.
 //
 // buildkite API docs: https://buildkite.com/docs/api/builds#list-builds-for-an-organization
@@ -335,6 +350,14 @@ func (bs *BuildsService) ListByOrg(ctx context.Context, org string, opt *BuildsL
 	return builds, resp, err
 }
 
+// ListByOrgAll is like ListByOrg but returns an iterator over the results from
+// every page, fetching each page as iteration reaches it.
+func (bs *BuildsService) ListByOrgAll(ctx context.Context, org string, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error) {
+		return bs.ListByOrg(ctx, org, opt)
+	})
+}
+
 // ListByPipeline lists the builds for a pipeline within the specified originisation.
 //
 // buildkite API docs: https://buildkite.com/docs/api/builds#list-builds-for-a-pipeline
@@ -359,21 +382,35 @@ func (bs *BuildsService) ListByPipeline(ctx context.Context, org string, pipelin
 	return builds, resp, err
 }
 
+// ListByPipelineAll is like ListByPipeline but returns an iterator over the
+// results from every page, fetching each page as iteration reaches it.
+func (bs *BuildsService) ListByPipelineAll(ctx context.Context, org string, pipeline string, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error) {
+		return bs.ListByPipeline(ctx, org, pipeline, opt)
+	})
+}
+
 // Rebuild triggers a rebuild for the target build
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/builds#rebuild-a-build
 func (bs *BuildsService) Rebuild(ctx context.Context, org, pipeline, buildNumber string) (Build, error) {
+	build, _, err := bs.rebuild(ctx, org, pipeline, buildNumber)
+	return build, err
+}
+
+// rebuild is Rebuild, also returning the Response.
+func (bs *BuildsService) rebuild(ctx context.Context, org, pipeline, buildNumber string) (Build, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/pipelines/%s/builds/%s/rebuild", org, pipeline, buildNumber)
 	req, err := bs.client.NewRequest(ctx, "PUT", u, nil)
 	if err != nil {
-		return Build{}, err
+		return Build{}, nil, err
 	}
 
 	var result Build
-	_, err = bs.client.Do(req, &result)
+	resp, err := bs.client.Do(req, &result)
 	if err != nil {
-		return Build{}, err
+		return Build{}, resp, err
 	}
 
-	return result, nil
+	return result, resp, nil
 }
@@ -0,0 +1,246 @@
+package buildkite
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is how many builds BuildsService.BulkCancel and
+// BuildsService.BulkRebuild act on at once by default.
+const DefaultBulkConcurrency = 4
+
+// BulkAction is what a bulk operation does to each build.
+type BulkAction string
+
+const (
+	// BulkActionCancel cancels each build, as BuildsService.Cancel does.
+	BulkActionCancel BulkAction = "cancel"
+
+	// BulkActionRebuild starts a new build of each build's commit, as
+	// BuildsService.Rebuild does.
+	BulkActionRebuild BulkAction = "rebuild"
+)
+
+// BulkOptions configures BuildsService.BulkCancel and
+// BuildsService.BulkRebuild.
+type BulkOptions struct {
+	// ListOptions filters the builds to act on, such as by branch and state.
+	// All matching builds are listed, from the first page, whatever its Page.
+	ListOptions *BuildsListOptions
+
+	// Filter, if set, is called with each listed build, and only the builds
+	// it returns true for are acted on.
+	Filter func(Build) bool
+
+	// Concurrency caps how many builds are acted on at once. Defaults to
+	// DefaultBulkConcurrency.
+	Concurrency int
+
+	// DryRun, if set, lists and filters the builds without acting on them,
+	// reporting each as BulkResult.DryRun.
+	DryRun bool
+}
+
+// BulkResult is the outcome of a bulk operation for one build.
+type BulkResult struct {
+	// Build is the build as listed.
+	Build Build
+
+	// Pipeline is the slug of the build's pipeline, or "" if it wasn't known,
+	// such as when listing an organization's builds with ExcludePipeline.
+	Pipeline string
+
+	// Result is the build the API returned: the canceled build, or the new
+	// build started by a rebuild. It is empty if Err is set or for a dry run.
+	Result Build
+
+	// Err is why the action failed, if it did.
+	Err error
+
+	// DryRun is set if the action wasn't taken, because BulkOptions.DryRun
+	// was set, or was recorded rather than sent, because the client was
+	// created with WithDryRun.
+	DryRun bool
+}
+
+// BulkReport is the outcome of a bulk operation.
+type BulkReport struct {
+	Action BulkAction
+
+	// Results has a result for each build acted on, in the order listed.
+	Results []BulkResult
+}
+
+// Failed returns the results of the builds the action failed for.
+func (r BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Err returns the errors of the builds the action failed for joined together,
+// or nil if it succeeded for all of them.
+func (r BulkReport) Err() error {
+	var errs []error
+	for _, res := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s build %d of pipeline %s: %w", r.Action, res.Build.Number, res.Pipeline, res.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// BulkCancel cancels the builds of a pipeline matching opt.ListOptions and
+// opt.Filter, or of every pipeline in the organization if pipeline is "". See
+// BuildsService.BulkRebuild.
+func (bs *BuildsService) BulkCancel(ctx context.Context, org, pipeline string, opt *BulkOptions) (BulkReport, error) {
+	return bs.bulk(ctx, BulkActionCancel, bs.cancel, org, pipeline, opt)
+}
+
+// BulkRebuild rebuilds the builds of a pipeline matching opt.ListOptions and
+// opt.Filter, or of every pipeline in the organization if pipeline is "".
+//
+// All matching builds are listed before any is acted on, so that the action
+// doesn't shift the pages of the listing. Up to opt.Concurrency builds are
+// then acted on at once. If the client was created with
+// WithRateLimitThrottle, the throttle paces the requests; otherwise, when a
+// response shows the rate limit budget spent, further requests wait for the
+// rate limit window to reset.
+//
+// A failure for one build doesn't stop the others; it is recorded in the
+// report, and BulkReport.Err gathers them. The error returned is from listing
+// the builds, along with a report of any acted on so far, or the context's
+// error if ctx is done, with the builds not yet acted on reporting it.
+func (bs *BuildsService) BulkRebuild(ctx context.Context, org, pipeline string, opt *BulkOptions) (BulkReport, error) {
+	return bs.bulk(ctx, BulkActionRebuild, bs.rebuild, org, pipeline, opt)
+}
+
+// bulkFunc acts on one build, returning the Response for its rate limit.
+type bulkFunc func(ctx context.Context, org, pipeline, buildNumber string) (Build, *Response, error)
+
+// bulk lists the builds matching opt and acts on them with do.
+func (bs *BuildsService) bulk(ctx context.Context, action BulkAction, do bulkFunc, org, pipeline string, opt *BulkOptions) (BulkReport, error) {
+	if opt == nil {
+		opt = &BulkOptions{}
+	}
+	report := BulkReport{Action: action}
+
+	var listOpt BuildsListOptions
+	if opt.ListOptions != nil {
+		listOpt = *opt.ListOptions
+	}
+	listOpt.Page = 0
+
+	var builds iter.Seq2[Build, error]
+	if pipeline == "" {
+		builds = bs.ListByOrgAll(ctx, org, &listOpt)
+	} else {
+		builds = bs.ListByPipelineAll(ctx, org, pipeline, &listOpt)
+	}
+	for build, err := range builds {
+		if err != nil {
+			return report, err
+		}
+		if opt.Filter == nil || opt.Filter(build) {
+			report.Results = append(report.Results, BulkResult{
+				Build:    build,
+				Pipeline: cmp.Or(pipeline, buildPipelineSlug(build)),
+				DryRun:   opt.DryRun,
+			})
+		}
+	}
+	if opt.DryRun {
+		return report, nil
+	}
+
+	var pause *bulkPause
+	if !bs.client.pacesRequests() {
+		pause = &bulkPause{}
+	}
+	work := make(chan *BulkResult)
+	var wg sync.WaitGroup
+	for range min(max(cmp.Or(opt.Concurrency, DefaultBulkConcurrency), 1), len(report.Results)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for res := range work {
+				if d := pause.until(); d > 0 {
+					if err := bs.client.sleep(ctx, d); err != nil {
+						res.Err = err
+						continue
+					}
+				}
+				bs.bulkOne(ctx, do, org, res, pause)
+			}
+		}()
+	}
+	for i := range report.Results {
+		work <- &report.Results[i]
+	}
+	close(work)
+	wg.Wait()
+
+	return report, ctx.Err()
+}
+
+// bulkOne acts on the build of res, recording the outcome in res.
+func (bs *BuildsService) bulkOne(ctx context.Context, do bulkFunc, org string, res *BulkResult, pause *bulkPause) {
+	if res.Pipeline == "" {
+		res.Err = errors.New("build has no pipeline; list builds without ExcludePipeline")
+		return
+	}
+
+	build, resp, err := do(ctx, org, res.Pipeline, strconv.Itoa(res.Build.Number))
+	if resp != nil {
+		if pause != nil && resp.Rate.Limit > 0 && resp.Rate.Remaining == 0 {
+			pause.extend(resp.Rate.ResetAt)
+		}
+		res.DryRun = resp.DryRun
+	}
+	res.Result, res.Err = build, err
+}
+
+// buildPipelineSlug returns the slug of a build's pipeline, if it was listed
+// with its pipeline.
+func buildPipelineSlug(b Build) string {
+	if b.Pipeline == nil {
+		return ""
+	}
+	return b.Pipeline.Slug
+}
+
+// bulkPause holds back the requests of a bulk operation until the rate limit
+// window resets once a response shows the budget spent. It is used only when
+// the client has no rate limit throttle, which would otherwise pace the same
+// requests a second time.
+type bulkPause struct {
+	mu       sync.Mutex
+	resumeAt time.Time
+}
+
+// until returns how long requests should wait. A nil bulkPause never waits.
+func (p *bulkPause) until() time.Duration {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return max(time.Until(p.resumeAt), 0)
+}
+
+// extend holds back requests until t, if that is later than already.
+func (p *bulkPause) extend(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.After(p.resumeAt) {
+		p.resumeAt = t
+	}
+}
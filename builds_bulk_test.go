@@ -0,0 +1,204 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildsService_BulkCancel(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValuesList(t, r, valuesList{{"branch[]", "main"}, {"state[]", "running"}})
+		_, _ = fmt.Fprint(w, `[{"number":3,"state":"running","message":"bad merge"},{"number":2,"state":"running","message":"keep"},{"number":1,"state":"running","message":"bad merge"}]`)
+	})
+
+	var mu sync.Mutex
+	var canceled []string
+	for _, n := range []string{"3", "1"} {
+		ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/"+n+"/cancel", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			mu.Lock()
+			canceled = append(canceled, n)
+			mu.Unlock()
+			if n == "1" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = fmt.Fprint(w, `{"message":"Build can't be canceled because it's already finished"}`)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"number":%s,"state":"canceling"}`, n)
+		})
+	}
+
+	report, err := client.Builds.BulkCancel(context.Background(), "my-great-org", "sup-keith", &BulkOptions{
+		ListOptions: &BuildsListOptions{Branch: []string{"main"}, State: []BuildState{BuildStateRunning}},
+		Filter:      func(b Build) bool { return b.Message == "bad merge" },
+	})
+	if err != nil {
+		t.Fatalf("BulkCancel returned error: %v", err)
+	}
+
+	if report.Action != BulkActionCancel || len(report.Results) != 2 {
+		t.Fatalf("BulkCancel report = %+v, want 2 cancel results", report)
+	}
+	if got := report.Results[0]; got.Build.Number != 3 || got.Result.State != BuildStateCanceling || got.Err != nil {
+		t.Errorf("BulkCancel result for build 3 = %+v", got)
+	}
+
+	failed := report.Failed()
+	var errResp *ErrorResponse
+	if len(failed) != 1 || failed[0].Build.Number != 1 || !errors.As(failed[0].Err, &errResp) {
+		t.Errorf("BulkCancel failures = %+v, want build 1 with an ErrorResponse", failed)
+	}
+	if err := report.Err(); err == nil || !strings.Contains(err.Error(), "cancel build 1 of pipeline sup-keith: ") {
+		t.Errorf("report.Err() = %v, want the failure for build 1", err)
+	}
+	if len(canceled) != 2 {
+		t.Errorf("canceled builds %v, want 3 and 1", canceled)
+	}
+}
+
+func TestBuildsService_BulkRebuild_Org(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/my-great-org/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[{"number":5,"state":"failed","pipeline":{"slug":"web"}},{"number":9,"state":"failed"}]`)
+	})
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/web/builds/5/rebuild", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		_, _ = fmt.Fprint(w, `{"number":6,"state":"scheduled"}`)
+	})
+
+	report, err := client.Org("my-great-org").Builds.BulkRebuild(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("BulkRebuild returned error: %v", err)
+	}
+
+	if got := report.Results[0]; got.Pipeline != "web" || got.Result.Number != 6 || got.Err != nil {
+		t.Errorf("BulkRebuild result for build 5 = %+v, want new build 6", got)
+	}
+	// Without its pipeline, build 9 can't be rebuilt.
+	if got := report.Results[1]; got.Err == nil {
+		t.Errorf("BulkRebuild result for build 9 = %+v, want an error", got)
+	}
+}
+
+func TestBuildsService_BulkRebuild_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	var delays []time.Duration
+	client.sleepFunc = func(d time.Duration) { delays = append(delays, d) }
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[{"number":2},{"number":1}]`)
+	})
+	for _, n := range []string{"2", "1"} {
+		ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/"+n+"/rebuild", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("RateLimit-Limit", "200")
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.Header().Set("RateLimit-Reset", "30")
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+	}
+
+	report, err := client.Builds.BulkRebuild(context.Background(), "my-great-org", "sup-keith", &BulkOptions{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("BulkRebuild returned error: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		t.Errorf("report.Err() = %v", err)
+	}
+
+	// The first rebuild spends the budget, so the second waits for the reset.
+	if len(delays) != 1 || delays[0] < 29*time.Second || delays[0] > 30*time.Second {
+		t.Errorf("delays = %v, want one of about 30s", delays)
+	}
+}
+
+func TestBuildsService_BulkCancel_DryRun(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `[{"number":2},{"number":1}]`)
+	})
+
+	// The mock server fails the test on any cancel request.
+	report, err := client.Builds.BulkCancel(context.Background(), "my-great-org", "sup-keith", &BulkOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("BulkCancel returned error: %v", err)
+	}
+
+	want := []BulkResult{
+		{Build: Build{Number: 2}, Pipeline: "sup-keith", DryRun: true},
+		{Build: Build{Number: 1}, Pipeline: "sup-keith", DryRun: true},
+	}
+	if diff := cmp.Diff(want, report.Results); diff != "" {
+		t.Errorf("BulkCancel results diff: (-want +got)\n%s", diff)
+	}
+}
+
+// With the client's rate limit throttle, bulk requests are paced only by it.
+func TestBuildsService_BulkRebuild_Throttled(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	var bulkDelays, throttleDelays []time.Duration
+	client.sleepFunc = func(d time.Duration) { bulkDelays = append(bulkDelays, d) }
+	client.throttle = newThrottle(0)
+	client.throttle.sleep = func(ctx context.Context, d time.Duration) error {
+		throttleDelays = append(throttleDelays, d)
+		return nil
+	}
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[{"number":2},{"number":1}]`)
+	})
+	for _, n := range []string{"2", "1"} {
+		ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/"+n+"/rebuild", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("RateLimit-Limit", "200")
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.Header().Set("RateLimit-Reset", "30")
+			_, _ = fmt.Fprint(w, `{}`)
+		})
+	}
+
+	report, err := client.Builds.BulkRebuild(context.Background(), "my-great-org", "sup-keith", &BulkOptions{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("BulkRebuild returned error: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		t.Errorf("report.Err() = %v", err)
+	}
+
+	if len(bulkDelays) != 0 {
+		t.Errorf("bulk operation paused for %v as well as the throttle", bulkDelays)
+	}
+	if len(throttleDelays) != 1 || throttleDelays[0] < 29*time.Second {
+		t.Errorf("throttle delays = %v, want one of about 30s", throttleDelays)
+	}
+}
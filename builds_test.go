@@ -147,7 +147,7 @@ func TestBuildsService_Get(t *testing.T) {
 		server, client, teardown := newMockServerAndClient(t)
 		t.Cleanup(teardown)
 
-		jobType := "manual"
+		jobType := JobTypeManual
 		unblockedAt := "2023-01-01T15:00:00.00Z"
 		parsedTime := must(time.Parse(BuildKiteDateFormat, unblockedAt))
 
@@ -276,7 +276,7 @@ func TestBuildsService_Get(t *testing.T) {
 			})
 
 		opt := &BuildGetOptions{
-			JobStates: []string{"failed"},
+			JobStates: []JobState{JobStateFailed},
 		}
 		build, _, err := client.Builds.Get(context.Background(), orgName, pipelineName, buildNumber, opt)
 		if err != nil {
@@ -306,7 +306,7 @@ func TestBuildsService_Get(t *testing.T) {
 			})
 
 		opt := &BuildGetOptions{
-			JobStates: []string{"failed", "broken"},
+			JobStates: []JobState{JobStateFailed, JobStateBroken},
 		}
 		build, _, err := client.Builds.Get(context.Background(), orgName, pipelineName, buildNumber, opt)
 		if err != nil {
@@ -336,7 +336,7 @@ func TestBuildsService_List_by_status(t *testing.T) {
 	})
 
 	opt := &BuildsListOptions{
-		State:       []string{"running"},
+		State:       []BuildState{BuildStateRunning},
 		ListOptions: ListOptions{Page: 2},
 	}
 	builds, _, err := client.Builds.List(context.Background(), opt)
@@ -367,7 +367,7 @@ func TestBuildsService_List_by_multiple_status(t *testing.T) {
 	})
 
 	opt := &BuildsListOptions{
-		State:       []string{"running", "scheduled"},
+		State:       []BuildState{BuildStateRunning, BuildStateScheduled},
 		ListOptions: ListOptions{Page: 2},
 	}
 	builds, _, err := client.Builds.List(context.Background(), opt)
@@ -0,0 +1,239 @@
+package buildkite
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultTreeConcurrency is how many builds BuildsService.Tree fetches at
+// once by default.
+const DefaultTreeConcurrency = 4
+
+// BuildTreeOptions configures BuildsService.Tree.
+type BuildTreeOptions struct {
+	// Ancestors, if set, first follows Build.TriggeredFrom up to the build
+	// that started the chain, and returns the tree from there, including the
+	// builds triggered alongside the requested one.
+	Ancestors bool
+
+	// MaxDepth limits how many levels of triggered builds are fetched below
+	// the root of the tree. Zero means no limit.
+	MaxDepth int
+
+	// Concurrency caps how many builds are fetched at once. Defaults to
+	// DefaultTreeConcurrency.
+	Concurrency int
+}
+
+// BuildTree is a build and the builds its trigger steps started.
+type BuildTree struct {
+	// Pipeline is the slug of the build's pipeline.
+	Pipeline string
+	Build    Build
+
+	// Trigger is the job in the parent build that triggered this build, or
+	// nil for the root of the tree.
+	Trigger *Job
+
+	// Children are the builds triggered by this build's jobs, in job order.
+	Children []*BuildTree
+}
+
+// All iterates over the builds in the tree, each before the builds it
+// triggered.
+func (t *BuildTree) All() iter.Seq[*BuildTree] {
+	return func(yield func(*BuildTree) bool) {
+		t.walk(yield)
+	}
+}
+
+// walk yields t and its descendants, reporting whether to carry on.
+func (t *BuildTree) walk(yield func(*BuildTree) bool) bool {
+	if !yield(t) {
+		return false
+	}
+	for _, child := range t.Children {
+		if !child.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// State returns the combined state of the builds in the tree. While any build
+// hasn't finished, it is BuildStateFailing if any build has failed or is
+// failing, or else BuildStateRunning. Once all have finished, it is the first
+// of failed, canceled or blocked that any build is in, or else passed if any
+// build passed. A tree of builds that were all skipped or not run has the
+// root's state.
+func (t *BuildTree) State() BuildState {
+	counts := map[BuildState]int{}
+	finished := true
+	for n := range t.All() {
+		counts[n.Build.State]++
+		finished = finished && n.Build.State.IsTerminal()
+	}
+	failure := counts[BuildStateFailed]+counts[BuildStateFailing] > 0
+
+	switch {
+	case !finished && failure:
+		return BuildStateFailing
+	case !finished:
+		return BuildStateRunning
+	case failure:
+		return BuildStateFailed
+	case counts[BuildStateCanceled] > 0:
+		return BuildStateCanceled
+	case counts[BuildStateBlocked] > 0:
+		return BuildStateBlocked
+	case counts[BuildStatePassed] > 0:
+		return BuildStatePassed
+	}
+	return t.Build.State
+}
+
+// Tree gets a build and, recursively, the builds started by its trigger
+// steps, fetching up to opt.Concurrency builds at once. Triggered builds can
+// be in other pipelines of the organization, which the token needs access to.
+// If fetching any build fails, Tree stops and returns the error.
+func (bs *BuildsService) Tree(ctx context.Context, org, pipeline, buildNumber string, opt *BuildTreeOptions) (*BuildTree, error) {
+	if opt == nil {
+		opt = &BuildTreeOptions{}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	w := &treeWalker{
+		bs:       bs,
+		org:      org,
+		ctx:      ctx,
+		cancel:   cancel,
+		sem:      make(chan struct{}, max(cmp.Or(opt.Concurrency, DefaultTreeConcurrency), 1)),
+		maxDepth: opt.MaxDepth,
+		seen:     map[string]bool{},
+	}
+
+	build, _, err := bs.Get(ctx, org, pipeline, buildNumber, nil)
+	if err != nil {
+		return nil, err
+	}
+	root := &BuildTree{Pipeline: pipeline, Build: build}
+
+	if opt.Ancestors {
+		seen := map[string]bool{build.ID: true}
+		for from := root.Build.TriggeredFrom; from != nil && from.BuildPipelineSlug != ""; from = root.Build.TriggeredFrom {
+			parent, err := w.get(from.BuildPipelineSlug, from.BuildNumber)
+			if err != nil {
+				return nil, err
+			}
+			if seen[parent.ID] {
+				break
+			}
+			seen[parent.ID] = true
+			root = &BuildTree{Pipeline: from.BuildPipelineSlug, Build: parent}
+		}
+	}
+
+	w.seen[root.Build.ID] = true
+	w.expand(root, 0)
+	w.wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// treeWalker fetches the builds of a BuildTree concurrently.
+type treeWalker struct {
+	bs       *BuildsService
+	org      string
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	sem      chan struct{}
+	maxDepth int
+	wg       sync.WaitGroup
+
+	mu   sync.Mutex
+	seen map[string]bool // IDs of builds in the tree
+}
+
+// get fetches a build once a slot is free.
+func (w *treeWalker) get(pipeline string, number int) (Build, error) {
+	select {
+	case w.sem <- struct{}{}:
+	case <-w.ctx.Done():
+		return Build{}, context.Cause(w.ctx)
+	}
+	defer func() { <-w.sem }()
+
+	build, _, err := w.bs.Get(w.ctx, w.org, pipeline, strconv.Itoa(number), nil)
+	if err != nil {
+		return Build{}, fmt.Errorf("getting build %d of pipeline %s: %w", number, pipeline, err)
+	}
+	return build, nil
+}
+
+// expand adds the builds triggered by node's jobs as its children, and
+// expands them in turn. depth is how many levels node is below the root.
+func (w *treeWalker) expand(node *BuildTree, depth int) {
+	if w.maxDepth > 0 && depth >= w.maxDepth {
+		return
+	}
+	for _, job := range node.Build.Jobs {
+		if job.Type != JobTypeTrigger || job.TriggeredBuild == nil {
+			continue
+		}
+		pipeline, ok := triggeredPipeline(job.TriggeredBuild)
+		if !ok {
+			w.cancel(fmt.Errorf("finding the pipeline of build %s triggered by job %s", job.TriggeredBuild.ID, job.ID))
+			return
+		}
+		child := &BuildTree{Pipeline: pipeline, Trigger: &job}
+		node.Children = append(node.Children, child)
+
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			build, err := w.get(pipeline, job.TriggeredBuild.Number)
+			if err != nil {
+				w.cancel(err)
+				return
+			}
+			child.Build = build
+
+			w.mu.Lock()
+			seen := w.seen[build.ID]
+			w.seen[build.ID] = true
+			w.mu.Unlock()
+			if !seen {
+				w.expand(child, depth+1)
+			}
+		}()
+	}
+}
+
+// triggeredPipeline returns the slug of a triggered build's pipeline, from
+// its API URL or, failing that, its web URL.
+func triggeredPipeline(tb *TriggeredBuild) (string, bool) {
+	if u, err := url.Parse(tb.URL); err == nil {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		for i, s := range segments[:max(len(segments)-1, 0)] {
+			if s == "pipelines" {
+				return segments[i+1], true
+			}
+		}
+	}
+	if u, err := url.Parse(tb.WebURL); err == nil {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(segments) >= 3 && segments[2] == "builds" {
+			return segments[1], true
+		}
+	}
+	return "", false
+}
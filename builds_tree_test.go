@@ -0,0 +1,204 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleTreeBuilds serves a release build that triggers builds of api and
+// web, where the api build triggers a deploy build. It returns a count of the
+// requests served.
+func handleTreeBuilds(t *testing.T, ms *mockServer, webState string) *atomic.Int32 {
+	t.Helper()
+
+	var requests atomic.Int32
+	trigger := func(id, pipeline string, number int) string {
+		return fmt.Sprintf(`{"id":%q,"type":"trigger","triggered_build":{"id":"%s-%d","number":%d,"url":"https://api.buildkite.com/v2/organizations/my-great-org/pipelines/%s/builds/%d"}}`,
+			id, pipeline, number, number, pipeline, number)
+	}
+	builds := map[string]string{
+		"release/1": `{"id":"release-1","number":1,"state":"running","jobs":[{"id":"j1","type":"script"},` +
+			trigger("j2", "api", 7) + `,` + trigger("j3", "web", 3) + `]}`,
+		"api/7": `{"id":"api-7","number":7,"state":"passed","triggered_from":{"build_id":"release-1","build_number":1,"build_pipeline_slug":"release"},"jobs":[` +
+			`{"id":"j4","type":"trigger","triggered_build":{"id":"deploy-2","number":2,"web_url":"https://buildkite.com/my-great-org/deploy/builds/2"}}]}`,
+		"web/3":    `{"id":"web-3","number":3,"state":"` + webState + `","triggered_from":{"build_id":"release-1","build_number":1,"build_pipeline_slug":"release"}}`,
+		"deploy/2": `{"id":"deploy-2","number":2,"state":"passed","triggered_from":{"build_id":"api-7","build_number":7,"build_pipeline_slug":"api"}}`,
+	}
+	for key, body := range builds {
+		pipeline, number, _ := strings.Cut(key, "/")
+		ms.HandleFunc(fmt.Sprintf("/v2/organizations/my-great-org/pipelines/%s/builds/%s", pipeline, number), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			requests.Add(1)
+			_, _ = fmt.Fprint(w, body)
+		})
+	}
+	return &requests
+}
+
+// treeShape returns the pipeline and number of each build in the tree, with
+// its depth.
+func treeShape(t *BuildTree) []string {
+	var out []string
+	var walk func(*BuildTree, int)
+	walk = func(n *BuildTree, depth int) {
+		out = append(out, fmt.Sprintf("%s%s/%d", strings.Repeat("  ", depth), n.Pipeline, n.Build.Number))
+		for _, c := range n.Children {
+			walk(c, depth+1)
+		}
+	}
+	walk(t, 0)
+	return out
+}
+
+func TestBuildsService_Tree(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	handleTreeBuilds(t, ms, "failed")
+
+	tree, err := client.Builds.Tree(context.Background(), "my-great-org", "release", "1", &BuildTreeOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("Tree returned error: %v", err)
+	}
+
+	want := []string{"release/1", "  api/7", "    deploy/2", "  web/3"}
+	if diff := cmp.Diff(want, treeShape(tree)); diff != "" {
+		t.Errorf("tree diff: (-want +got)\n%s", diff)
+	}
+	if tree.Trigger != nil || tree.Children[0].Trigger.ID != "j2" || tree.Children[0].Children[0].Trigger.ID != "j4" {
+		t.Errorf("unexpected trigger jobs in tree")
+	}
+	if got := tree.State(); got != BuildStateFailing {
+		t.Errorf("State() = %q, want failing", got)
+	}
+}
+
+func TestBuildsService_Tree_Ancestors(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	handleTreeBuilds(t, ms, "passed")
+
+	tree, err := client.Builds.Tree(context.Background(), "my-great-org", "deploy", "2", &BuildTreeOptions{Ancestors: true})
+	if err != nil {
+		t.Fatalf("Tree returned error: %v", err)
+	}
+
+	want := []string{"release/1", "  api/7", "    deploy/2", "  web/3"}
+	if diff := cmp.Diff(want, treeShape(tree)); diff != "" {
+		t.Errorf("tree diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestBuildsService_Tree_MaxDepth(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	requests := handleTreeBuilds(t, ms, "passed")
+
+	tree, err := client.Builds.Tree(context.Background(), "my-great-org", "release", "1", &BuildTreeOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("Tree returned error: %v", err)
+	}
+
+	want := []string{"release/1", "  api/7", "  web/3"}
+	if diff := cmp.Diff(want, treeShape(tree)); diff != "" {
+		t.Errorf("tree diff: (-want +got)\n%s", diff)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Tree made %d requests, want 3", got)
+	}
+}
+
+func TestBuildsService_Tree_Error(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/release/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"id":"release-1","number":1,"state":"running","jobs":[`+
+			`{"id":"j1","type":"trigger","triggered_build":{"id":"secret-1","number":1,"url":"https://api.buildkite.com/v2/organizations/my-great-org/pipelines/secret/builds/1"}}]}`)
+	})
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/secret/builds/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{"message":"Forbidden"}`)
+	})
+
+	tree, err := client.Builds.Tree(context.Background(), "my-great-org", "release", "1", nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusForbidden {
+		t.Fatalf("Tree error = %v, want a 403 ErrorResponse", err)
+	}
+	if tree != nil {
+		t.Errorf("Tree returned a tree with its error")
+	}
+}
+
+func TestBuildTree_State(t *testing.T) {
+	t.Parallel()
+
+	tree := func(states ...BuildState) *BuildTree {
+		root := &BuildTree{Build: Build{State: states[0]}}
+		for _, s := range states[1:] {
+			root.Children = append(root.Children, &BuildTree{Build: Build{State: s}})
+		}
+		return root
+	}
+
+	tests := []struct {
+		name string
+		tree *BuildTree
+		want BuildState
+	}{
+		{"all passed", tree(BuildStatePassed, BuildStatePassed), BuildStatePassed},
+		{"child running", tree(BuildStatePassed, BuildStateRunning), BuildStateRunning},
+		{"failed while running", tree(BuildStateRunning, BuildStateFailed), BuildStateFailing},
+		{"failed", tree(BuildStatePassed, BuildStateFailed, BuildStateCanceled), BuildStateFailed},
+		{"canceled", tree(BuildStateCanceled, BuildStatePassed), BuildStateCanceled},
+		{"blocked", tree(BuildStatePassed, BuildStateBlocked), BuildStateBlocked},
+		{"skipped child", tree(BuildStatePassed, BuildStateSkipped), BuildStatePassed},
+		{"not run", tree(BuildStateNotRun), BuildStateNotRun},
+		{"unknown state", tree(BuildStatePassed, "some_new_state"), BuildStateRunning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tree.State(); got != tt.want {
+				t.Errorf("State() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTriggeredPipeline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tb   TriggeredBuild
+		want string
+		ok   bool
+	}{
+		{TriggeredBuild{URL: "https://api.buildkite.com/v2/organizations/acme/pipelines/web/builds/4"}, "web", true},
+		{TriggeredBuild{WebURL: "https://buildkite.com/acme/web/builds/4"}, "web", true},
+		{TriggeredBuild{URL: "https://api.buildkite.com/v2/organizations/acme/pipelines"}, "", false},
+		{TriggeredBuild{}, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := triggeredPipeline(&tt.tb)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("triggeredPipeline(%+v) = %q, %v, want %q, %v", tt.tb, got, ok, tt.want, tt.ok)
+		}
+	}
+}
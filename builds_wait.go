@@ -0,0 +1,155 @@
+package buildkite
+
+import (
+	"cmp"
+	"context"
+	"strconv"
+	"time"
+)
+
+// Default polling intervals for BuildsService.WaitForBuild.
+const (
+	DefaultWaitMinInterval = 5 * time.Second
+	DefaultWaitMaxInterval = 60 * time.Second
+)
+
+// BuildOutcome is how a build finished, as reported by
+// BuildsService.WaitForBuild.
+type BuildOutcome string
+
+const (
+	BuildOutcomePassed   BuildOutcome = "passed"
+	BuildOutcomeFailed   BuildOutcome = "failed"
+	BuildOutcomeCanceled BuildOutcome = "canceled"
+
+	// BuildOutcomeBlocked is a build that stopped at a block step, waiting to
+	// be unblocked.
+	BuildOutcomeBlocked BuildOutcome = "blocked"
+
+	// BuildOutcomeSkipped is a build that was skipped or not run, such as by
+	// build skipping on a busy branch.
+	BuildOutcomeSkipped BuildOutcome = "skipped"
+)
+
+// buildOutcome returns the outcome of a build in state, or false if the build
+// hasn't finished.
+func buildOutcome(state BuildState) (BuildOutcome, bool) {
+	switch state {
+	case BuildStatePassed:
+		return BuildOutcomePassed, true
+	case BuildStateFailed:
+		return BuildOutcomeFailed, true
+	case BuildStateCanceled:
+		return BuildOutcomeCanceled, true
+	case BuildStateBlocked:
+		return BuildOutcomeBlocked, true
+	case BuildStateSkipped, BuildStateNotRun:
+		return BuildOutcomeSkipped, true
+	}
+	return "", false
+}
+
+// WaitForBuildOptions configures BuildsService.WaitForBuild.
+type WaitForBuildOptions struct {
+	// GetOptions is passed to BuildsService.Get for each poll. Set ExcludeJobs
+	// to poll more cheaply when OnJobStateChange isn't needed.
+	GetOptions *BuildGetOptions
+
+	// MinInterval is the delay between polls while the build is changing.
+	// Defaults to DefaultWaitMinInterval.
+	MinInterval time.Duration
+
+	// MaxInterval caps the delay between polls, which doubles with each poll
+	// that finds nothing changed. Defaults to DefaultWaitMaxInterval.
+	MaxInterval time.Duration
+
+	// OnBuildStateChange, if set, is called when the build's state changes,
+	// and with the state first seen. from is the previous state, or "" on the
+	// first poll.
+	OnBuildStateChange func(build Build, from BuildState)
+
+	// OnJobStateChange, if set, is called when one of the build's jobs
+	// changes state, and for each job when it is first seen. from is the
+	// job's previous state, or "" when it is first seen. It is called for the
+	// jobs of a poll before OnBuildStateChange.
+	OnJobStateChange func(build Build, job Job, from JobState)
+}
+
+// WaitForBuild polls a build until it finishes, and returns it along with how
+// it finished. The delay between polls starts at opt.MinInterval and backs off
+// to opt.MaxInterval while nothing changes, and a poll that finds the rate
+// limit budget spent waits for the rate limit window to reset.
+//
+// A blocked build counts as finished, with BuildOutcomeBlocked. WaitForBuild
+// returns early with the context's error if ctx is done, or with the error of
+// a failed poll, along with the build as last seen, if any.
+func (bs *BuildsService) WaitForBuild(ctx context.Context, org, pipeline, buildNumber string, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	if opt == nil {
+		opt = &WaitForBuildOptions{}
+	}
+	minInterval := cmp.Or(opt.MinInterval, DefaultWaitMinInterval)
+	maxInterval := max(cmp.Or(opt.MaxInterval, DefaultWaitMaxInterval), minInterval)
+
+	var (
+		last       Build
+		seen       bool
+		jobStates  = map[string]JobState{}
+		interval   = minInterval
+		buildState BuildState
+	)
+	for {
+		build, resp, err := bs.Get(ctx, org, pipeline, buildNumber, opt.GetOptions)
+		if err != nil {
+			return last, "", err
+		}
+		last = build
+
+		changed := false
+		for _, job := range build.Jobs {
+			from, ok := jobStates[job.ID]
+			if ok && from == job.State {
+				continue
+			}
+			jobStates[job.ID] = job.State
+			changed = true
+			if opt.OnJobStateChange != nil {
+				opt.OnJobStateChange(build, job, from)
+			}
+		}
+		if !seen || build.State != buildState {
+			from := buildState
+			buildState, seen = build.State, true
+			changed = true
+			if opt.OnBuildStateChange != nil {
+				opt.OnBuildStateChange(build, from)
+			}
+		}
+
+		if outcome, done := buildOutcome(build.State); done {
+			return build, outcome, nil
+		}
+
+		if changed {
+			interval = minInterval
+		} else {
+			interval = min(interval*2, maxInterval)
+		}
+		delay := interval
+		if resp.Rate.Limit > 0 && resp.Rate.Remaining == 0 {
+			delay = max(delay, resp.Rate.Reset)
+		}
+		if err := bs.client.sleep(ctx, delay); err != nil {
+			return last, "", err
+		}
+	}
+}
+
+// CreateAndWait creates a build with BuildsService.Create, then waits for it
+// to finish with BuildsService.WaitForBuild.
+func (bs *BuildsService) CreateAndWait(ctx context.Context, org, pipeline string, b CreateBuild, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	build, _, err := bs.Create(ctx, org, pipeline, b)
+	if err != nil {
+		return Build{}, "", err
+	}
+	return bs.WaitForBuild(ctx, org, pipeline, strconv.Itoa(build.Number), opt)
+}
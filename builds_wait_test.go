@@ -0,0 +1,159 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildsService_WaitForBuild(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+
+	var delays []time.Duration
+	client.sleepFunc = func(d time.Duration) { delays = append(delays, d) }
+
+	polls := []string{
+		`{"number":42,"state":"scheduled","jobs":[{"id":"j1","state":"scheduled"}]}`,
+		`{"number":42,"state":"scheduled","jobs":[{"id":"j1","state":"scheduled"}]}`,
+		`{"number":42,"state":"running","jobs":[{"id":"j1","state":"running"},{"id":"j2","state":"waiting"}]}`,
+		`{"number":42,"state":"failed","jobs":[{"id":"j1","state":"failed"},{"id":"j2","state":"waiting_failed"}]}`,
+	}
+	poll := 0
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if poll == 1 {
+			w.Header().Set("RateLimit-Limit", "200")
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.Header().Set("RateLimit-Reset", "30")
+		}
+		_, _ = fmt.Fprint(w, polls[poll])
+		poll++
+	})
+
+	var events []string
+	build, outcome, err := client.Builds.WaitForBuild(context.Background(), "my-great-org", "sup-keith", "42", &WaitForBuildOptions{
+		OnBuildStateChange: func(build Build, from BuildState) {
+			events = append(events, fmt.Sprintf("build %s->%s", from, build.State))
+		},
+		OnJobStateChange: func(build Build, job Job, from JobState) {
+			events = append(events, fmt.Sprintf("job %s %s->%s", job.ID, from, job.State))
+		},
+	})
+	if err != nil {
+		t.Fatalf("WaitForBuild returned error: %v", err)
+	}
+
+	if outcome != BuildOutcomeFailed || build.State != "failed" {
+		t.Errorf("WaitForBuild returned %q, %q, want failed", outcome, build.State)
+	}
+
+	wantEvents := []string{
+		"job j1 ->scheduled",
+		"build ->scheduled",
+		"job j1 scheduled->running",
+		"job j2 ->waiting",
+		"build scheduled->running",
+		"job j1 running->failed",
+		"job j2 waiting->waiting_failed",
+		"build running->failed",
+	}
+	if diff := cmp.Diff(wantEvents, events); diff != "" {
+		t.Errorf("state change events diff: (-want +got)\n%s", diff)
+	}
+
+	// The second poll changes nothing and finds the rate limit spent.
+	wantDelays := []time.Duration{DefaultWaitMinInterval, 30 * time.Second, DefaultWaitMinInterval}
+	if diff := cmp.Diff(wantDelays, delays); diff != "" {
+		t.Errorf("poll delays diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestBuildsService_WaitForBuild_Backoff(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+
+	var delays []time.Duration
+	client.sleepFunc = func(d time.Duration) { delays = append(delays, d) }
+
+	poll := 0
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/42", func(w http.ResponseWriter, r *http.Request) {
+		poll++
+		state := "running"
+		if poll == 6 {
+			state = "blocked"
+		}
+		_, _ = fmt.Fprintf(w, `{"number":42,"state":%q}`, state)
+	})
+
+	_, outcome, err := client.Builds.WaitForBuild(context.Background(), "my-great-org", "sup-keith", "42", &WaitForBuildOptions{
+		MinInterval: time.Second,
+		MaxInterval: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("WaitForBuild returned error: %v", err)
+	}
+	if outcome != BuildOutcomeBlocked {
+		t.Errorf("outcome = %q, want blocked", outcome)
+	}
+
+	wantDelays := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	if diff := cmp.Diff(wantDelays, delays); diff != "" {
+		t.Errorf("poll delays diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestBuildsService_WaitForBuild_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.sleepFunc = func(time.Duration) { cancel() }
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/42", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"number":42,"state":"running"}`))
+	})
+
+	build, _, err := client.Builds.WaitForBuild(ctx, "my-great-org", "sup-keith", "42", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForBuild error = %v, want context.Canceled", err)
+	}
+	if build.State != "running" {
+		t.Errorf("expected the build as last seen, got %+v", build)
+	}
+}
+
+func TestBuildsService_CreateAndWait(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		_, _ = w.Write([]byte(`{"number":7,"state":"scheduled"}`))
+	})
+	ms.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(`{"number":7,"state":"passed"}`))
+	})
+
+	build, outcome, err := client.Builds.CreateAndWait(context.Background(), "my-great-org", "sup-keith", CreateBuild{Commit: "HEAD", Branch: "main"}, nil)
+	if err != nil {
+		t.Fatalf("CreateAndWait returned error: %v", err)
+	}
+	if build.Number != 7 || outcome != BuildOutcomePassed {
+		t.Errorf("CreateAndWait returned build %d, %q, want 7, passed", build.Number, outcome)
+	}
+}
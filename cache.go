@@ -0,0 +1,289 @@
+package buildkite
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxCacheableBodyBytes is the largest response body the cache will buffer.
+// Larger responses, such as artifact downloads, are passed through uncached.
+const maxCacheableBodyBytes = 8 << 20
+
+// CachedResponse is a response stored by a CacheStore, along with the
+// validators used to revalidate it.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+}
+
+// CacheStore stores responses for conditional GET requests. Keys identify a
+// request's method, URL and credentials; they are opaque and do not contain
+// the credentials themselves. Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the response stored under key, if any.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores resp under key, replacing any existing entry.
+	Set(key string, resp *CachedResponse)
+
+	// Delete removes the response stored under key, if any.
+	Delete(key string)
+}
+
+// WithResponseCache enables conditional GET caching. Successful GET responses
+// carrying an ETag or Last-Modified header are stored in store, and later
+// GETs of the same URL with the same credentials send If-None-Match or
+// If-Modified-Since. When the API answers 304 Not Modified, the stored
+// response is returned in its place, so callers see the same result as for an
+// unconditional request. Requests that set their own conditional headers
+// bypass the cache, and a successful non-GET request to a URL evicts its
+// cached GET.
+//
+// Use NewLRUCache for an in-memory store with size and age limits.
+func WithResponseCache(store CacheStore) ClientOpt {
+	return func(c *Client) error {
+		c.cache = store
+		return nil
+	}
+}
+
+// cacheKey identifies the cached response for req. The Authorization header is
+// hashed so different tokens never share entries, and headers that select a
+// representation are included.
+func cacheKey(method string, req *http.Request) string {
+	h := sha256.New()
+	for _, part := range []string{
+		method,
+		req.URL.String(),
+		req.Header.Get("Authorization"),
+		req.Header.Get("Accept"),
+		req.Header.Get("Buildkite-Version"),
+	} {
+		_, _ = io.WriteString(h, part)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cachingDoer wraps a Doer with conditional GET caching backed by a CacheStore.
+type cachingDoer struct {
+	next  Doer
+	store CacheStore
+}
+
+func (d cachingDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := d.next.Do(req)
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			d.store.Delete(cacheKey(http.MethodGet, req))
+		}
+		return resp, err
+	}
+
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return d.next.Do(req)
+	}
+
+	key := cacheKey(http.MethodGet, req)
+	cached, ok := d.store.Get(key)
+
+	if ok {
+		// Clone so the conditional headers don't leak into later attempts.
+		req = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := d.next.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		// Headers sent with a 304, such as Date and RateLimit-*, update the
+		// stored ones.
+		header := cached.Header.Clone()
+		for k, v := range resp.Header {
+			header[k] = v
+		}
+		refreshed := &CachedResponse{
+			StatusCode: cached.StatusCode,
+			Header:     header,
+			Body:       cached.Body,
+			StoredAt:   time.Now(),
+		}
+		d.store.Set(key, refreshed)
+
+		return cachedHTTPResponse(req, refreshed), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	// Read no more than is cacheable, then stitch what was read back in front
+	// of the rest of the body.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCacheableBodyBytes+1))
+	if err != nil || len(data) > maxCacheableBodyBytes {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	d.store.Set(key, &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       data,
+		StoredAt:   time.Now(),
+	})
+
+	return resp, nil
+}
+
+// cachedHTTPResponse builds a response to req from a cached entry.
+func cachedHTTPResponse(req *http.Request, cached *CachedResponse) *http.Response {
+	header := cached.Header.Clone()
+	header.Set("Content-Length", strconv.Itoa(len(cached.Body)))
+
+	return &http.Response{
+		Status:        strconv.Itoa(cached.StatusCode) + " " + http.StatusText(cached.StatusCode),
+		StatusCode:    cached.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
+
+// LRUCacheOptions configures an LRUCache. Zero values mean no limit.
+type LRUCacheOptions struct {
+	// MaxEntries is the maximum number of responses stored.
+	MaxEntries int
+
+	// MaxBytes is the maximum total size of the stored response bodies.
+	// Responses larger than MaxBytes are not stored.
+	MaxBytes int64
+
+	// TTL is how long a response is kept after it was stored or last
+	// revalidated.
+	TTL time.Duration
+}
+
+// LRUCache is an in-memory CacheStore that evicts the least recently used
+// responses once its entry or size limits are reached, and expires responses
+// older than its TTL.
+type LRUCache struct {
+	opts LRUCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front is most recently used
+	bytes   int64
+
+	now func() time.Time
+}
+
+type lruEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewLRUCache returns an empty LRUCache with the given limits.
+func NewLRUCache(opts LRUCacheOptions) *LRUCache {
+	return &LRUCache{
+		opts:    opts,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns the response stored under key, unless it has expired.
+func (c *LRUCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	resp := el.Value.(*lruEntry).resp
+	if c.opts.TTL > 0 && c.now().Sub(resp.StoredAt) > c.opts.TTL {
+		c.removeLocked(el)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return resp, true
+}
+
+// Set stores resp under key, evicting the least recently used responses as
+// needed to stay within the cache's limits.
+func (c *LRUCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.removeLocked(el)
+	}
+
+	size := int64(len(resp.Body))
+	if c.opts.MaxBytes > 0 && size > c.opts.MaxBytes {
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, resp: resp})
+	c.bytes += size
+
+	for (c.opts.MaxEntries > 0 && c.order.Len() > c.opts.MaxEntries) ||
+		(c.opts.MaxBytes > 0 && c.bytes > c.opts.MaxBytes) {
+		c.removeLocked(c.order.Back())
+	}
+}
+
+// Delete removes the response stored under key, if any.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.removeLocked(el)
+	}
+}
+
+// Len returns the number of responses stored.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRUCache) removeLocked(el *list.Element) {
+	entry := c.order.Remove(el).(*lruEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= int64(len(entry.resp.Body))
+}
@@ -0,0 +1,225 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithResponseCache_ETag(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	if err := WithResponseCache(NewLRUCache(LRUCacheOptions{}))(client); err != nil {
+		t.Fatalf("WithResponseCache: %v", err)
+	}
+
+	var conditional []string
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines/my-pipeline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+
+		w.Header().Set("RateLimit-Limit", "200")
+		w.Header().Set("RateLimit-Remaining", fmt.Sprint(200-len(conditional)))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = fmt.Fprint(w, `{"slug":"my-pipeline","name":"My Pipeline"}`)
+	})
+
+	for i := range 3 {
+		pipeline, resp, err := client.Pipelines.Get(context.Background(), "my-great-org", "my-pipeline")
+		if err != nil {
+			t.Fatalf("Pipelines.Get #%d returned error: %v", i+1, err)
+		}
+		if pipeline.Name != "My Pipeline" {
+			t.Errorf("Pipelines.Get #%d name = %q, want %q", i+1, pipeline.Name, "My Pipeline")
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Pipelines.Get #%d status = %d, want 200", i+1, resp.StatusCode)
+		}
+		if want := 200 - (i + 1); resp.Rate.Remaining != want {
+			t.Errorf("Pipelines.Get #%d Rate.Remaining = %d, want %d from the latest response", i+1, resp.Rate.Remaining, want)
+		}
+	}
+
+	if want := []string{"", `"v1"`, `"v1"`}; fmt.Sprint(conditional) != fmt.Sprint(want) {
+		t.Errorf("If-None-Match headers = %q, want %q", conditional, want)
+	}
+}
+
+func TestWithResponseCache_LastModified(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	if err := WithResponseCache(NewLRUCache(LRUCacheOptions{}))(client); err != nil {
+		t.Fatalf("WithResponseCache: %v", err)
+	}
+
+	const lastModified = "Mon, 15 Dec 2025 05:40:00 GMT"
+	calls := 0
+	server.HandleFunc("/v2/organizations/my-great-org/agents", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if calls > 1 {
+			t.Errorf("request #%d did not send If-Modified-Since", calls)
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = fmt.Fprint(w, `[{"id":"agent-1"}]`)
+	})
+
+	for range 2 {
+		agents, _, err := client.Agents.List(context.Background(), "my-great-org", nil)
+		if err != nil {
+			t.Fatalf("Agents.List returned error: %v", err)
+		}
+		if len(agents) != 1 || agents[0].ID != "agent-1" {
+			t.Errorf("Agents.List = %+v, want agent-1", agents)
+		}
+	}
+}
+
+// TestWithResponseCache_KeyedByAuth verifies clients with different tokens
+// sharing a store never revalidate against each other's entries.
+func TestWithResponseCache_KeyedByAuth(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	store := NewLRUCache(LRUCacheOptions{})
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines/my-pipeline", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("If-None-Match"); got != "" {
+			t.Errorf("unexpected If-None-Match %q for %s", got, r.Header.Get("Authorization"))
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = fmt.Fprint(w, `{"slug":"my-pipeline"}`)
+	})
+
+	for _, token := range []string{"token-a", "token-b"} {
+		if err := WithTokenAuth(token)(client); err != nil {
+			t.Fatalf("WithTokenAuth: %v", err)
+		}
+		if err := WithResponseCache(store)(client); err != nil {
+			t.Fatalf("WithResponseCache: %v", err)
+		}
+		if _, _, err := client.Pipelines.Get(context.Background(), "my-great-org", "my-pipeline"); err != nil {
+			t.Fatalf("Pipelines.Get returned error: %v", err)
+		}
+	}
+
+	if store.Len() != 2 {
+		t.Errorf("expected 2 cache entries, got %d", store.Len())
+	}
+}
+
+// TestWithResponseCache_InvalidatedByWrite verifies a successful update to a
+// URL evicts its cached GET.
+func TestWithResponseCache_InvalidatedByWrite(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	store := NewLRUCache(LRUCacheOptions{})
+	if err := WithResponseCache(store)(client); err != nil {
+		t.Fatalf("WithResponseCache: %v", err)
+	}
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines/my-pipeline", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = fmt.Fprint(w, `{"slug":"my-pipeline"}`)
+		case http.MethodPatch:
+			_, _ = fmt.Fprint(w, `{"slug":"my-pipeline"}`)
+		}
+	})
+
+	if _, _, err := client.Pipelines.Get(context.Background(), "my-great-org", "my-pipeline"); err != nil {
+		t.Fatalf("Pipelines.Get returned error: %v", err)
+	}
+	if store.Len() != 1 {
+		t.Fatalf("expected 1 cache entry after GET, got %d", store.Len())
+	}
+
+	if _, _, err := client.Pipelines.Update(context.Background(), "my-great-org", "my-pipeline", UpdatePipeline{Name: Some("renamed")}); err != nil {
+		t.Fatalf("Pipelines.Update returned error: %v", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("expected cache entry to be evicted by PATCH, got %d entries", store.Len())
+	}
+}
+
+func TestLRUCache_Limits(t *testing.T) {
+	t.Parallel()
+
+	entry := func(body string) *CachedResponse {
+		return &CachedResponse{StatusCode: http.StatusOK, Header: http.Header{}, Body: []byte(body), StoredAt: time.Now()}
+	}
+
+	t.Run("MaxEntries evicts least recently used", func(t *testing.T) {
+		c := NewLRUCache(LRUCacheOptions{MaxEntries: 2})
+		c.Set("a", entry("a"))
+		c.Set("b", entry("b"))
+		c.Get("a")
+		c.Set("c", entry("c"))
+
+		if _, ok := c.Get("b"); ok {
+			t.Error("expected b to be evicted")
+		}
+		for _, key := range []string{"a", "c"} {
+			if _, ok := c.Get(key); !ok {
+				t.Errorf("expected %s to be cached", key)
+			}
+		}
+	})
+
+	t.Run("MaxBytes", func(t *testing.T) {
+		c := NewLRUCache(LRUCacheOptions{MaxBytes: 10})
+		c.Set("a", entry("aaaa"))
+		c.Set("b", entry("bbbb"))
+		c.Set("c", entry("cccc"))
+		c.Set("huge", entry("this body is too big"))
+
+		if _, ok := c.Get("a"); ok {
+			t.Error("expected a to be evicted to make room")
+		}
+		if _, ok := c.Get("huge"); ok {
+			t.Error("expected body larger than MaxBytes not to be stored")
+		}
+		if c.Len() != 2 {
+			t.Errorf("expected 2 entries, got %d", c.Len())
+		}
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		now := time.Now()
+		c := NewLRUCache(LRUCacheOptions{TTL: time.Minute})
+		c.now = func() time.Time { return now }
+		c.Set("a", entry("a"))
+
+		if _, ok := c.Get("a"); !ok {
+			t.Error("expected a to be cached within its TTL")
+		}
+		now = now.Add(2 * time.Minute)
+		if _, ok := c.Get("a"); ok {
+			t.Error("expected a to expire after its TTL")
+		}
+		if c.Len() != 0 {
+			t.Errorf("expected expired entry to be removed, got %d entries", c.Len())
+		}
+	})
+}
@@ -0,0 +1,281 @@
+package buildkite
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCircuitCooldown is how long a circuit stays open before letting a
+// probe request through, unless CircuitBreaker.Cooldown is set.
+const DefaultCircuitCooldown = 30 * time.Second
+
+// ErrCircuitOpen is matched by the *CircuitOpenError returned for requests
+// rejected by an open circuit.
+var ErrCircuitOpen = errors.New("buildkite: circuit open")
+
+// CircuitState is the state of a circuit of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through, counting consecutive failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen rejects requests with a *CircuitOpenError until the
+	// cooldown has passed.
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single probe request through. The circuit closes
+	// if it succeeds and opens again if it fails.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreaker configures the client-side circuit breaker enabled by
+// WithCircuitBreaker. Requests are divided into groups, each with its own
+// circuit, which opens after Threshold consecutive failures: 5xx responses,
+// or round trips that time out or are cut off. Responses with other statuses,
+// including 4xx and 429, close the circuit.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failures that opens a circuit.
+	// It must be at least 1.
+	Threshold int
+
+	// Cooldown is how long a circuit stays open before half-opening to let a
+	// probe request through. Defaults to DefaultCircuitCooldown.
+	Cooldown time.Duration
+
+	// Group returns the name of the circuit a request belongs to. Defaults to
+	// CircuitByHost; CircuitByEndpoint gives each kind of resource its own
+	// circuit.
+	Group func(*http.Request) string
+
+	// OnStateChange, if set, is called each time a circuit changes state,
+	// such as to raise an alert when one opens. It is called from the
+	// goroutine of the request that caused the change, which waits for it to
+	// return.
+	OnStateChange func(group string, from, to CircuitState)
+}
+
+// CircuitByHost groups requests by the host they are sent to, so REST and
+// GraphQL requests have separate circuits.
+func CircuitByHost(req *http.Request) string {
+	return req.URL.Host
+}
+
+// CircuitByEndpoint groups requests by host and the kinds of resource in
+// their path, ignoring identifiers. For example, requests for
+// /v2/organizations/acme/pipelines/web/builds/42 and
+// /v2/organizations/acme/pipelines/api/builds are both in the group
+// "api.buildkite.com/organizations/pipelines/builds".
+func CircuitByEndpoint(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) > 0 && segments[0] == "v2" {
+		segments = segments[1:]
+	}
+	// Some paths, such as those of the analytics and packages APIs, are
+	// prefixed before the organization.
+	for i, s := range segments {
+		if s == "organizations" {
+			segments = segments[i:]
+			break
+		}
+	}
+
+	kinds := []string{req.URL.Host}
+	for i := 0; i < len(segments); i += 2 {
+		kinds = append(kinds, segments[i])
+	}
+	return strings.Join(kinds, "/")
+}
+
+// CircuitOpenError is returned by Client.Do for a request rejected, without
+// being sent, by an open circuit.
+type CircuitOpenError struct {
+	Group   string    // the circuit's group, as returned by CircuitBreaker.Group
+	RetryAt time.Time // when the circuit will let a probe request through
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("buildkite: circuit %q open until %s", e.Group, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// breaker tracks the circuits of a CircuitBreaker.
+type breaker struct {
+	config CircuitBreaker
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	changes  []circuitChange // state changes to notify once mu is unlocked
+
+	now func() time.Time
+}
+
+// circuitChange is a change of a circuit's state.
+type circuitChange struct {
+	group    string
+	from, to CircuitState
+}
+
+// circuit is the state of one group's circuit.
+type circuit struct {
+	state    CircuitState
+	failures int       // consecutive failures while closed
+	openedAt time.Time // when the circuit last opened
+	probing  bool      // whether the half-open probe is in flight
+}
+
+func newBreaker(config CircuitBreaker) *breaker {
+	if config.Cooldown == 0 {
+		config.Cooldown = DefaultCircuitCooldown
+	}
+	if config.Group == nil {
+		config.Group = CircuitByHost
+	}
+	return &breaker{
+		config:   config,
+		circuits: map[string]*circuit{},
+		now:      time.Now,
+	}
+}
+
+// group returns the name of req's circuit.
+func (b *breaker) group(req *http.Request) string {
+	return b.config.Group(req)
+}
+
+// allow returns a *CircuitOpenError if the group's circuit rejects a request.
+// Otherwise the request may be sent, and its outcome must be passed to record
+// or release.
+func (b *breaker) allow(group string) error {
+	b.mu.Lock()
+	defer b.unlock()
+
+	c := b.circuits[group]
+	if c == nil {
+		return nil
+	}
+
+	switch c.state {
+	case CircuitOpen:
+		retryAt := c.openedAt.Add(b.config.Cooldown)
+		if b.now().Before(retryAt) {
+			return &CircuitOpenError{Group: group, RetryAt: retryAt}
+		}
+		b.setLocked(group, c, CircuitHalfOpen)
+		c.probing = true
+		return nil
+
+	case CircuitHalfOpen:
+		if c.probing {
+			return &CircuitOpenError{Group: group, RetryAt: c.openedAt.Add(b.config.Cooldown)}
+		}
+		c.probing = true
+		return nil
+	}
+	return nil
+}
+
+// record updates the group's circuit with the outcome of a request let
+// through by allow.
+func (b *breaker) record(group string, failed bool) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	c := b.circuits[group]
+	if c == nil {
+		if !failed {
+			return
+		}
+		c = &circuit{}
+		b.circuits[group] = c
+	}
+	c.probing = false
+
+	if !failed {
+		c.failures = 0
+		b.setLocked(group, c, CircuitClosed)
+		return
+	}
+
+	c.failures++
+	if c.state == CircuitHalfOpen || c.failures >= b.config.Threshold {
+		c.openedAt = b.now()
+		b.setLocked(group, c, CircuitOpen)
+	}
+}
+
+// done records the outcome of a round trip let through by allow, which got
+// resp or err.
+func (b *breaker) done(group string, req *http.Request, resp *http.Response, err error) {
+	if failed, ok := circuitFailure(req, resp, err); ok {
+		b.record(group, failed)
+	} else {
+		b.release(group)
+	}
+}
+
+// release returns the probe of a half-open circuit without an outcome, such
+// as when the request's context was done before a response arrived.
+func (b *breaker) release(group string) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	if c := b.circuits[group]; c != nil {
+		c.probing = false
+	}
+}
+
+// setLocked moves c to state, queueing any change for OnStateChange.
+func (b *breaker) setLocked(group string, c *circuit, state CircuitState) {
+	if c.state != state && b.config.OnStateChange != nil {
+		b.changes = append(b.changes, circuitChange{group: group, from: c.state, to: state})
+	}
+	c.state = state
+}
+
+// unlock unlocks b.mu, then passes the queued state changes to OnStateChange
+// so that it may use the Client.
+func (b *breaker) unlock() {
+	changes := b.changes
+	b.changes = nil
+	b.mu.Unlock()
+
+	for _, ch := range changes {
+		b.config.OnStateChange(ch.group, ch.from, ch.to)
+	}
+}
+
+// circuitFailure reports whether a round trip that got resp or err counts as a
+// failure, and whether it has an outcome at all: a round trip abandoned with
+// its context, or failing other than by timing out or being cut off, says
+// nothing about the health of the API.
+func circuitFailure(req *http.Request, resp *http.Response, err error) (failed, ok bool) {
+	if err != nil {
+		if req.Context().Err() != nil || !isTransientNetworkError(err) {
+			return false, false
+		}
+		return true, true
+	}
+	return resp.StatusCode >= 500 && resp.StatusCode <= 599, true
+}
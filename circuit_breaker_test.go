@@ -0,0 +1,168 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	type change struct{ from, to CircuitState }
+	var changes []change
+
+	now := time.Date(2025, time.December, 15, 5, 40, 0, 0, time.UTC)
+	client.maxRetries = 0
+	client.breaker = newBreaker(CircuitBreaker{
+		Threshold: 2,
+		Cooldown:  10 * time.Second,
+		OnStateChange: func(group string, from, to CircuitState) {
+			changes = append(changes, change{from, to})
+		},
+	})
+	client.breaker.now = func() time.Time { return now }
+
+	calls := 0
+	status := http.StatusInternalServerError
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	})
+
+	do := func() error {
+		t.Helper()
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.Do(req, nil)
+		return err
+	}
+
+	for range 2 {
+		if err := do(); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected 500 error, got %v", err)
+		}
+	}
+
+	err := do()
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected *CircuitOpenError, got %v", err)
+	}
+	if want := now.Add(10 * time.Second); !openErr.RetryAt.Equal(want) {
+		t.Errorf("RetryAt = %v, want %v", openErr.RetryAt, want)
+	}
+	if calls != 2 {
+		t.Errorf("expected open circuit to fail fast, got %d calls", calls)
+	}
+
+	// The probe after the cooldown fails, so the circuit opens again.
+	now = now.Add(10 * time.Second)
+	if err := do(); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected probe to get 500 error, got %v", err)
+	}
+	if err := do(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected circuit to open again, got %v", err)
+	}
+
+	// The next probe succeeds, closing the circuit.
+	now = now.Add(10 * time.Second)
+	status = http.StatusOK
+	if err := do(); err != nil {
+		t.Fatalf("expected probe to succeed, got %v", err)
+	}
+	if err := do(); err != nil {
+		t.Fatalf("expected closed circuit, got %v", err)
+	}
+
+	want := []change{
+		{CircuitClosed, CircuitOpen},
+		{CircuitOpen, CircuitHalfOpen},
+		{CircuitHalfOpen, CircuitOpen},
+		{CircuitOpen, CircuitHalfOpen},
+		{CircuitHalfOpen, CircuitClosed},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("state changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("state change %d = %v, want %v", i, changes[i], want[i])
+		}
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	t.Parallel()
+
+	b := newBreaker(CircuitBreaker{Threshold: 2})
+	b.record("g", true)
+	b.record("g", false)
+	b.record("g", true)
+	if err := b.allow("g"); err != nil {
+		t.Errorf("expected failures separated by a success not to open the circuit, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenAllowsOneProbe(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.December, 15, 5, 40, 0, 0, time.UTC)
+	b := newBreaker(CircuitBreaker{Threshold: 1})
+	b.now = func() time.Time { return now }
+
+	b.record("g", true)
+	now = now.Add(DefaultCircuitCooldown)
+
+	if err := b.allow("g"); err != nil {
+		t.Fatalf("expected probe to be allowed, got %v", err)
+	}
+	if err := b.allow("g"); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected second request to be rejected while probing, got %v", err)
+	}
+
+	b.release("g")
+	if err := b.allow("g"); err != nil {
+		t.Errorf("expected another probe after release, got %v", err)
+	}
+}
+
+func TestCircuitByEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/v2/organizations/acme/pipelines/web/builds/42", "api.buildkite.com/organizations/pipelines/builds"},
+		{"/v2/organizations/acme/pipelines/api/builds", "api.buildkite.com/organizations/pipelines/builds"},
+		{"/v2/analytics/organizations/acme/suites/web/tests", "api.buildkite.com/organizations/suites/tests"},
+		{"/v2/builds", "api.buildkite.com/builds"},
+		{"/v2/access-token", "api.buildkite.com/access-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := &http.Request{URL: &url.URL{Host: "api.buildkite.com", Path: tt.path}}
+			if got := CircuitByEndpoint(req); got != tt.want {
+				t.Errorf("CircuitByEndpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCircuitBreaker_RejectsZeroThreshold(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(WithCircuitBreaker(CircuitBreaker{})); err == nil {
+		t.Error("expected error for zero threshold, got nil")
+	}
+}
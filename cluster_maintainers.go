@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ClusterMaintainersService handles API calls for cluster maintainer assignments.
@@ -40,6 +41,14 @@ func (cms *ClusterMaintainersService) List(ctx context.Context, org, clusterID s
 	return maintainers, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (cms *ClusterMaintainersService) ListAll(ctx context.Context, org, clusterID string, opt *ClusterMaintainersListOptions) iter.Seq2[ClusterMaintainerEntry, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ClusterMaintainersListOptions) ([]ClusterMaintainerEntry, *Response, error) {
+		return cms.List(ctx, org, clusterID, opt)
+	})
+}
+
 // Get returns one maintainer assignment by ID.
 func (cms *ClusterMaintainersService) Get(ctx context.Context, org, clusterID, id string) (ClusterMaintainerEntry, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/clusters/%s/maintainers/%s", org, clusterID, id)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ClusterQueuesService handles communication with cluster queue related
@@ -79,6 +80,14 @@ func (cqs *ClusterQueuesService) List(ctx context.Context, org, clusterID string
 	return queues, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (cqs *ClusterQueuesService) ListAll(ctx context.Context, org, clusterID string, opt *ClusterQueuesListOptions) iter.Seq2[ClusterQueue, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ClusterQueuesListOptions) ([]ClusterQueue, *Response, error) {
+		return cqs.List(ctx, org, clusterID, opt)
+	})
+}
+
 func (cqs *ClusterQueuesService) Get(ctx context.Context, org, clusterID, queueID string) (ClusterQueue, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/clusters/%s/queues/%s", org, clusterID, queueID)
 	req, err := cqs.client.NewRequest(ctx, "GET", u, nil)
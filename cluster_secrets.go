@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ClusterSecretsService handles communication with cluster secret related
@@ -86,6 +87,14 @@ func (css *ClusterSecretsService) List(ctx context.Context, org, clusterID strin
 	return secrets, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (css *ClusterSecretsService) ListAll(ctx context.Context, org, clusterID string, opt *ClusterSecretsListOptions) iter.Seq2[ClusterSecret, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ClusterSecretsListOptions) ([]ClusterSecret, *Response, error) {
+		return css.List(ctx, org, clusterID, opt)
+	})
+}
+
 func (css *ClusterSecretsService) Get(ctx context.Context, org, clusterID, secretID string) (ClusterSecret, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/clusters/%s/secrets/%s", org, clusterID, secretID)
 	req, err := css.client.NewRequest(ctx, "GET", u, nil)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ClusterTokensService handles communication with cluster token related
@@ -63,6 +64,14 @@ func (cts *ClusterTokensService) List(ctx context.Context, org, clusterID string
 	return tokens, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (cts *ClusterTokensService) ListAll(ctx context.Context, org, clusterID string, opt *ClusterTokensListOptions) iter.Seq2[ClusterToken, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ClusterTokensListOptions) ([]ClusterToken, *Response, error) {
+		return cts.List(ctx, org, clusterID, opt)
+	})
+}
+
 func (cts *ClusterTokensService) Get(ctx context.Context, org, clusterID, tokenID string) (ClusterToken, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/clusters/%s/tokens/%s", org, clusterID, tokenID)
 	req, err := cts.client.NewRequest(ctx, "GET", u, nil)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // ClustersService handles communication with cluster related
@@ -105,6 +106,14 @@ func (cs *ClustersService) List(ctx context.Context, org string, opt *ClustersLi
 	return clusters, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (cs *ClustersService) ListAll(ctx context.Context, org string, opt *ClustersListOptions) iter.Seq2[Cluster, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *ClustersListOptions) ([]Cluster, *Response, error) {
+		return cs.List(ctx, org, opt)
+	})
+}
+
 func (cs *ClustersService) Get(ctx context.Context, org, id string) (Cluster, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/clusters/%s", org, id)
 	req, err := cs.client.NewRequest(ctx, "GET", u, nil)
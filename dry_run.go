@@ -0,0 +1,149 @@
+package buildkite
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
+)
+
+// dryRunHeader marks the synthetic responses to requests recorded in dry-run
+// mode.
+const dryRunHeader = "Buildkite-Dry-Run"
+
+// DryRunRequest is a request recorded, rather than sent, in dry-run mode.
+type DryRunRequest struct {
+	Method string
+	URL    string
+
+	// ContentType and ContentLength are those of the request's body, if it
+	// has one. ContentLength is -1 if the length wasn't known.
+	ContentType   string
+	ContentLength int64
+
+	// Body is the request's JSON body with secret fields, such as tokens and
+	// cluster secret values, redacted. It is nil for requests without a JSON
+	// body, such as package uploads, whose bodies aren't read.
+	Body json.RawMessage
+}
+
+// DryRunSink receives the requests recorded in dry-run mode. It may be called
+// concurrently by requests made from different goroutines.
+type DryRunSink func(DryRunRequest)
+
+// WithDryRun enables dry-run mode, in which requests that could change
+// anything are passed to sink rather than sent to the API. That is every
+// request other than GET, HEAD and OPTIONS requests and GraphQL queries, which
+// are sent as usual so that code deciding what to change can still read the
+// current state.
+//
+// Recorded requests are answered with a synthetic 200 response, whose
+// Response.DryRun is set, echoing the request's JSON body, or with an empty
+// JSON object if it has none or it isn't JSON. Results decoded from it only hold the values
+// that were sent; server-assigned fields such as IDs are empty.
+//
+// Use a DryRunPlan's Record method as the sink to collect a reviewable plan.
+func WithDryRun(sink DryRunSink) ClientOpt {
+	return func(c *Client) error {
+		c.dryRun = sink
+		return nil
+	}
+}
+
+// DryRunPlan collects the requests recorded in dry-run mode, in the order
+// they were made. It is safe for concurrent use.
+type DryRunPlan struct {
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+// Record adds req to the plan. It is a DryRunSink.
+func (p *DryRunPlan) Record(req DryRunRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, req)
+}
+
+// Requests returns the requests recorded so far.
+func (p *DryRunPlan) Requests() []DryRunRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]DryRunRequest(nil), p.requests...)
+}
+
+// dryRunDoer wraps a Doer, recording requests that could change anything to
+// a DryRunSink and answering them itself.
+type dryRunDoer struct {
+	next Doer
+	sink DryRunSink
+}
+
+func (d dryRunDoer) Do(req *http.Request) (*http.Response, error) {
+	if !changesState(req) {
+		return d.next.Do(req)
+	}
+
+	recorded := DryRunRequest{
+		Method:        req.Method,
+		URL:           req.URL.String(),
+		ContentType:   req.Header.Get("Content-Type"),
+		ContentLength: req.ContentLength,
+	}
+	respBody := []byte("{}")
+
+	// Only JSON bodies are read, to be recorded and echoed. Others, such as
+	// package uploads, may be large and are recorded by type and length.
+	if req.Body != nil {
+		var data []byte
+		var err error
+		mediaType, _, _ := mime.ParseMediaType(recorded.ContentType)
+		if mediaType == "application/json" {
+			data, err = io.ReadAll(req.Body)
+		}
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var body any
+		if data != nil && json.Unmarshal(data, &body) == nil {
+			respBody = data
+			// Redaction modifies body in place, so respBody keeps the values sent.
+			if redacted, err := json.Marshal(redact.JSON(body, redact.ValueIsSecret(req.URL.Path))); err == nil {
+				recorded.Body = redacted
+			}
+		}
+	}
+
+	d.sink(recorded)
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			dryRunHeader:   []string{"true"},
+		},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// changesState reports whether req could change anything, so must not be
+// sent in dry-run mode.
+func changesState(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	query, _ := req.Context().Value(idempotentKey{}).(bool)
+	return !query
+}
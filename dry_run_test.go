@@ -0,0 +1,121 @@
+package buildkite
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	var plan DryRunPlan
+	client.dryRun = plan.Record
+
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("%s request sent in dry-run mode", r.Method)
+		}
+		_, _ = w.Write([]byte(`{"slug":"web","name":"Web"}`))
+	})
+
+	ctx := context.Background()
+
+	existing, resp, err := client.Pipelines.Get(ctx, "acme", "web")
+	if err != nil {
+		t.Fatalf("Pipelines.Get: %v", err)
+	}
+	if existing.Name != "Web" || resp.DryRun {
+		t.Errorf("expected GET to be sent, got %+v, DryRun=%v", existing, resp.DryRun)
+	}
+
+	created, resp, err := client.Pipelines.Create(ctx, "acme", CreatePipeline{Name: "API", Repository: "git@example.com:api.git"})
+	if err != nil {
+		t.Fatalf("Pipelines.Create: %v", err)
+	}
+	if !resp.DryRun {
+		t.Error("expected Create response to be marked DryRun")
+	}
+	if created.Name != "API" || created.Repository != "git@example.com:api.git" {
+		t.Errorf("expected synthetic pipeline echoing the request, got %+v", created)
+	}
+
+	if _, err := client.ClusterSecrets.UpdateValue(ctx, "acme", "c1", "s1", ClusterSecretValueUpdate{Value: "hunter2"}); err != nil {
+		t.Fatalf("ClusterSecrets.UpdateValue: %v", err)
+	}
+
+	if _, err := client.Pipelines.Delete(ctx, "acme", "web"); err != nil {
+		t.Fatalf("Pipelines.Delete: %v", err)
+	}
+
+	requests := plan.Requests()
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 recorded requests, got %d: %+v", len(requests), requests)
+	}
+
+	if got := requests[0]; got.Method != http.MethodPost || !strings.HasSuffix(got.URL, "/v2/organizations/acme/pipelines") {
+		t.Errorf("unexpected Create request: %s %s", got.Method, got.URL)
+	}
+	if body := string(requests[0].Body); !strings.Contains(body, `"name":"API"`) {
+		t.Errorf("expected Create body to include the name, got %s", body)
+	}
+
+	if got := requests[1]; got.Method != http.MethodPut {
+		t.Errorf("unexpected UpdateValue method: %s", got.Method)
+	}
+	assertJSONEqual(t, string(requests[1].Body), `{"value":"[REDACTED]"}`)
+
+	if got := requests[2]; got.Method != http.MethodDelete || got.Body != nil {
+		t.Errorf("unexpected Delete request: %+v", got)
+	}
+}
+
+// unreadBody fails the test if a request body is read.
+type unreadBody struct{ t *testing.T }
+
+func (b unreadBody) Read([]byte) (int, error) {
+	b.t.Error("non-JSON request body read in dry-run mode")
+	return 0, io.EOF
+}
+
+func TestDryRun_NonJSONBody(t *testing.T) {
+	t.Parallel()
+
+	_, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	var plan DryRunPlan
+	client.dryRun = plan.Record
+
+	req, err := client.NewRequest(context.Background(), "POST", "v2/packages/organizations/acme/registries/gems/packages", unreadBody{t})
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	req.ContentLength = 1 << 30
+
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if !resp.DryRun {
+		t.Error("expected response to be marked DryRun")
+	}
+
+	want := DryRunRequest{
+		Method:        http.MethodPost,
+		URL:           req.URL.String(),
+		ContentType:   "multipart/form-data; boundary=x",
+		ContentLength: 1 << 30,
+	}
+	if diff := cmp.Diff([]DryRunRequest{want}, plan.Requests()); diff != "" {
+		t.Errorf("recorded requests diff: (-want +got)\n%s", diff)
+	}
+}
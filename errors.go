@@ -0,0 +1,164 @@
+package buildkite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Sentinel errors matched by an *ErrorResponse with errors.Is, according to
+// the response's status code. For example:
+//
+//	_, _, err := client.Pipelines.Get(ctx, org, slug)
+//	if errors.Is(err, buildkite.ErrNotFound) {
+//		// the pipeline doesn't exist, or the token can't see it
+//	}
+var (
+	// ErrUnauthorized matches 401 responses, such as for a missing or revoked token.
+	ErrUnauthorized = errors.New("buildkite: unauthorized")
+
+	// ErrForbidden matches 403 responses, such as for a token missing a required scope.
+	ErrForbidden = errors.New("buildkite: forbidden")
+
+	// ErrNotFound matches 404 responses.
+	ErrNotFound = errors.New("buildkite: not found")
+
+	// ErrGone matches 410 responses, such as for step uploads of builds past
+	// their maximum lifetime.
+	ErrGone = errors.New("buildkite: gone")
+
+	// ErrValidation matches 422 responses. The rejected fields, when reported
+	// by the API, are in ErrorResponse.Errors.
+	ErrValidation = errors.New("buildkite: validation failed")
+
+	// ErrRateLimited matches 429 responses, which are returned once retries
+	// are exhausted or disabled.
+	ErrRateLimited = errors.New("buildkite: rate limited")
+)
+
+// statusErrors maps response status codes to the sentinel errors they match.
+var statusErrors = map[int]error{
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusGone:                ErrGone,
+	http.StatusUnprocessableEntity: ErrValidation,
+	http.StatusTooManyRequests:     ErrRateLimited,
+}
+
+// Is reports whether target is the sentinel error for the response's status
+// code, so that errors.Is(err, ErrNotFound) matches a 404 *ErrorResponse.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+	sentinel, ok := statusErrors[r.Response.StatusCode]
+	return ok && sentinel == target
+}
+
+// FieldError describes a single problem with a request, as reported in the
+// "errors" array of a Buildkite API error response. Typically Field names the
+// attribute that was rejected and Code or Message says why; some endpoints
+// report only a Message.
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// UnmarshalJSON accepts both the object form of a field error and a bare
+// string message.
+func (e *FieldError) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*e = FieldError{Message: message}
+		return nil
+	}
+
+	type fieldErrorAlias FieldError
+	return json.Unmarshal(data, (*fieldErrorAlias)(e))
+}
+
+func (e FieldError) Error() string {
+	detail := e.Message
+	if detail == "" {
+		detail = e.Code
+	}
+
+	switch {
+	case e.Field == "":
+		return detail
+	case detail == "":
+		return e.Field
+	default:
+		return fmt.Sprintf("%s: %s", e.Field, detail)
+	}
+}
+
+// fieldErrorsString formats errs for inclusion in an ErrorResponse message.
+func fieldErrorsString(errs []FieldError) string {
+	parts := make([]string, 0, len(errs))
+	for _, e := range errs {
+		parts = append(parts, e.Error())
+	}
+	return strings.Join(parts, "; ")
+}
+
+// UnmarshalJSON decodes an API error body. Besides the usual array of field
+// errors, it accepts an "errors" object mapping fields to messages, and
+// ignores any other shape rather than failing to decode the message.
+func (r *ErrorResponse) UnmarshalJSON(data []byte) error {
+	var body struct {
+		Message string          `json:"message"`
+		Errors  json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	r.Message = body.Message
+	r.Errors = decodeFieldErrors(body.Errors)
+	return nil
+}
+
+// decodeFieldErrors decodes the "errors" member of an API error body, or
+// returns nil if it is absent or has an unrecognised shape.
+func decodeFieldErrors(data json.RawMessage) []FieldError {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var list []FieldError
+	if err := json.Unmarshal(data, &list); err == nil {
+		return list
+	}
+
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(data, &byField); err != nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(byField))
+	for field := range byField {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		var messages []string
+		if err := json.Unmarshal(byField[field], &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(byField[field], &message); err != nil {
+				continue
+			}
+			messages = []string{message}
+		}
+		for _, message := range messages {
+			list = append(list, FieldError{Field: field, Message: message})
+		}
+	}
+	return list
+}
@@ -0,0 +1,165 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{status: http.StatusUnauthorized, body: `{"message":"Authentication required"}`, want: ErrUnauthorized},
+		{status: http.StatusForbidden, body: `{"message":"Forbidden"}`, want: ErrForbidden},
+		{status: http.StatusNotFound, body: `{"message":"No pipeline found"}`, want: ErrNotFound},
+		{status: http.StatusGone, body: `{"message":"Gone"}`, want: ErrGone},
+		{status: http.StatusUnprocessableEntity, body: `{"message":"Validation Failed"}`, want: ErrValidation},
+		// A body that isn't JSON still matches, through the wrapped *ErrorResponse.
+		{status: http.StatusNotFound, body: `Not Found`, want: ErrNotFound},
+	}
+
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrGone, ErrValidation, ErrRateLimited}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d %s", tt.status, tt.body), func(t *testing.T) {
+			t.Parallel()
+
+			server, client, teardown := newMockServerAndClient(t)
+			t.Cleanup(teardown)
+
+			server.HandleFunc("/v2/organizations/my-great-org/pipelines/my-pipeline", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			})
+
+			_, _, err := client.Pipelines.Get(context.Background(), "my-great-org", "my-pipeline")
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.want; got != want {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorResponse_Is_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = fmt.Fprint(w, `{"message":"rate limit exceeded"}`)
+	})
+
+	if err := WithMaxRetries(0)(client); err != nil {
+		t.Fatalf("WithMaxRetries(0): %v", err)
+	}
+
+	_, _, err := client.Pipelines.List(context.Background(), "my-great-org", nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected ErrRateLimited, got %v", err)
+	}
+}
+
+func TestErrorResponse_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want []FieldError
+	}{
+		{
+			name: "array of objects",
+			body: `{"message":"Validation Failed","errors":[{"field":"name","code":"already_exists"},{"field":"repository","message":"is not a valid URL"}]}`,
+			want: []FieldError{
+				{Field: "name", Code: "already_exists"},
+				{Field: "repository", Message: "is not a valid URL"},
+			},
+		},
+		{
+			name: "array of strings",
+			body: `{"message":"Validation Failed","errors":["Name can't be blank"]}`,
+			want: []FieldError{{Message: "Name can't be blank"}},
+		},
+		{
+			name: "object keyed by field",
+			body: `{"message":"Validation Failed","errors":{"steps":["must not be empty"],"name":"is taken"}}`,
+			want: []FieldError{
+				{Field: "name", Message: "is taken"},
+				{Field: "steps", Message: "must not be empty"},
+			},
+		},
+		{
+			name: "unrecognised shape",
+			body: `{"message":"Validation Failed","errors":42}`,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, client, teardown := newMockServerAndClient(t)
+			t.Cleanup(teardown)
+
+			server.HandleFunc("/v2/organizations/my-great-org/pipelines", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = fmt.Fprint(w, tt.body)
+			})
+
+			_, _, err := client.Pipelines.Create(context.Background(), "my-great-org", CreatePipeline{Name: "my-pipeline"})
+
+			var errResp *ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Fatalf("expected *ErrorResponse, got %T: %v", err, err)
+			}
+			if errResp.Message != "Validation Failed" {
+				t.Errorf("Message = %q, want %q", errResp.Message, "Validation Failed")
+			}
+			if diff := cmp.Diff(errResp.Errors, tt.want); diff != "" {
+				t.Errorf("Errors diff: (-got +want)\n%s", diff)
+			}
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
+
+func TestErrorResponse_Error(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest(http.MethodPost, "https://api.buildkite.com/v2/organizations/org/pipelines", nil)
+	err := &ErrorResponse{
+		Response: &http.Response{Request: req, StatusCode: http.StatusUnprocessableEntity},
+		Message:  "Validation Failed",
+		Errors:   []FieldError{{Field: "name", Code: "blank"}, {Message: "Repository is invalid"}},
+	}
+
+	want := "POST https://api.buildkite.com/v2/organizations/org/pipelines: 422 Validation Failed (name: blank; Repository is invalid)"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if strings.Contains((&ErrorResponse{Response: err.Response, Message: "x"}).Error(), "(") {
+		t.Error("Error() without field errors should not include a field error list")
+	}
+}
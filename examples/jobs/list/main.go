@@ -35,8 +35,13 @@ func main() {
 		log.Fatalf("creating buildkite API client failed: %v", err)
 	}
 
+	var states []buildkite.JobState
+	for _, s := range *state {
+		states = append(states, buildkite.JobState(s))
+	}
+
 	opt := &buildkite.JobsListOptions{
-		State:              *state,
+		State:              states,
 		IncludeRetriedJobs: includeRetriedJobs,
 		PerPage:            *perPage,
 		After:              *after,
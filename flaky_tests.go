@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // FlakyTestsService handles communication with flaky test related
@@ -46,3 +47,11 @@ func (fts *FlakyTestsService) List(ctx context.Context, org, slug string, opt *F
 
 	return flakyTests, resp, err
 }
+
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (fts *FlakyTestsService) ListAll(ctx context.Context, org, slug string, opt *FlakyTestsListOptions) iter.Seq2[FlakyTest, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *FlakyTestsListOptions) ([]FlakyTest, *Response, error) {
+		return fts.List(ctx, org, slug, opt)
+	})
+}
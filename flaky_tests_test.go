@@ -80,3 +80,51 @@ func TestFlakyTestsService_List(t *testing.T) {
 		t.Errorf("FlakyTests.List diff: (-got +want)\n%s", diff)
 	}
 }
+
+func TestFlakyTestsService_ListAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	const path = "/v2/analytics/organizations/my-great-org/suites/suite-example/flaky-tests"
+	server.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.buildkite.com`+path+`?page=2>; rel="next"`)
+			_, _ = fmt.Fprint(w, `[{"id":"flaky-1","name":"TestExample1_Create","instances":1,"most_recent_instance_at":"2023-05-19T20:00:02.223Z"}]`)
+		case "2":
+			_, _ = fmt.Fprint(w, `[{"id":"flaky-2","name":"TestExample1_Delete","instances":2,"most_recent_instance_at":"2023-07-10T13:14:03.214Z"}]`)
+		default:
+			t.Fatalf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	var got []FlakyTest
+	for test, err := range client.Org("my-great-org").FlakyTests.ListAll(context.Background(), "suite-example", nil) {
+		if err != nil {
+			t.Fatalf("FlakyTests.ListAll returned error: %v", err)
+		}
+		got = append(got, test)
+	}
+
+	want := []FlakyTest{
+		{
+			ID:                   "flaky-1",
+			Name:                 "TestExample1_Create",
+			Instances:            1,
+			MostRecentInstanceAt: NewTimestamp(must(time.Parse(BuildKiteDateFormat, "2023-05-19T20:00:02.223Z"))),
+		},
+		{
+			ID:                   "flaky-2",
+			Name:                 "TestExample1_Delete",
+			Instances:            2,
+			MostRecentInstanceAt: NewTimestamp(must(time.Parse(BuildKiteDateFormat, "2023-07-10T13:14:03.214Z"))),
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("FlakyTests.ListAll diff: (-got +want)\n%s", diff)
+	}
+}
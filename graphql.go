@@ -0,0 +1,286 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"strings"
+)
+
+// GraphQLService handles communication with the buildkite GraphQL API, for
+// queries the REST API doesn't cover, such as fetching a build with its jobs
+// and their agents in one round trip.
+//
+// Requests go through the same authentication, user agent, middleware,
+// logging, rate limit and retry handling as REST requests. Queries are
+// treated as idempotent by a RetryPolicy; mutations are only retried under
+// one with NonIdempotent set.
+//
+// buildkite API docs: https://buildkite.com/docs/apis/graphql-api
+type GraphQLService struct {
+	client *Client
+}
+
+// GraphQLError is an error reported in the "errors" array of a GraphQL
+// response.
+type GraphQLError struct {
+	Message   string                 `json:"message"`
+	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
+
+	// Path is the path of the field the error applies to, made up of field
+	// names (strings) and list indices (numbers).
+	Path []any `json:"path,omitempty"`
+
+	// Extensions holds any additional details reported with the error.
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorLocation is a position in a GraphQL query document.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+
+	parts := make([]string, len(e.Path))
+	for i, p := range e.Path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(parts, "."), e.Message)
+}
+
+// GraphQLErrors is the error returned by GraphQLService.Do when a GraphQL
+// response reports errors. Use errors.As to inspect them.
+type GraphQLErrors []GraphQLError
+
+func (errs GraphQLErrors) Error() string {
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		parts[i] = e.Error()
+	}
+	return "graphql: " + strings.Join(parts, "; ")
+}
+
+// graphQLRequest is the body of a GraphQL request.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the body of a GraphQL response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// Do sends query with the given variables and decodes the "data" member of the
+// response into out, which should be a pointer to a struct shaped like the
+// query's selection. out may be nil to discard the data.
+//
+// When the response reports errors, Do returns them as GraphQLErrors, after
+// decoding any partial data into out. HTTP-level failures are returned as an
+// *ErrorResponse, as for REST requests.
+//
+// buildkite API docs: https://buildkite.com/docs/apis/graphql-api
+func (gs *GraphQLService) Do(ctx context.Context, query string, vars map[string]any, out any) (*Response, error) {
+	if !isGraphQLMutation(query) {
+		ctx = context.WithValue(ctx, idempotentKey{}, true)
+	}
+
+	req, err := gs.client.NewRequest(ctx, "POST", gs.client.GraphQLURL.String(), graphQLRequest{
+		Query:     query,
+		Variables: vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body graphQLResponse
+	resp, err := gs.client.Do(req, &body)
+	if err != nil {
+		return resp, err
+	}
+
+	if out != nil && len(body.Data) > 0 && string(body.Data) != "null" {
+		if err := json.Unmarshal(body.Data, out); err != nil {
+			return resp, fmt.Errorf("decoding graphql data: %w", err)
+		}
+	}
+
+	if len(body.Errors) > 0 {
+		return resp, body.Errors
+	}
+	return resp, nil
+}
+
+// isGraphQLMutation reports whether a GraphQL document defines a mutation or
+// subscription, by looking at the first word of each top-level definition.
+func isGraphQLMutation(query string) bool {
+	depth := 0
+	atDefinition := true
+
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(query[i:], `"""`):
+			// Skip block strings, which may contain quotes and braces, and
+			// in which only \""" is an escape.
+			for i += 3; i < len(query) && !strings.HasPrefix(query[i:], `"""`); i++ {
+				if strings.HasPrefix(query[i:], `\"""`) {
+					i += 3
+				}
+			}
+			i += 2
+		case c == '"':
+			// Skip string values, which may contain braces.
+			for i++; i < len(query) && query[i] != '"'; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case c == '{':
+			depth++
+			atDefinition = false
+		case c == '}':
+			depth--
+			if depth == 0 {
+				atDefinition = true
+			}
+		case depth == 0 && atDefinition && isGraphQLNameChar(c):
+			start := i
+			for i < len(query) && isGraphQLNameChar(query[i]) {
+				i++
+			}
+			switch query[start:i] {
+			case "mutation", "subscription":
+				return true
+			}
+			atDefinition = false
+			i--
+		}
+	}
+	return false
+}
+
+func isGraphQLNameChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// GraphQLPageInfo is the pageInfo of a GraphQL connection.
+type GraphQLPageInfo struct {
+	HasNextPage     bool   `json:"hasNextPage"`
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+	StartCursor     string `json:"startCursor"`
+	EndCursor       string `json:"endCursor"`
+}
+
+// GraphQLEdge is an edge of a GraphQL connection.
+type GraphQLEdge[T any] struct {
+	Cursor string `json:"cursor"`
+	Node   T      `json:"node"`
+}
+
+// GraphQLConnection is a page of a GraphQL connection, selected with either
+// edges { node { ... } } or nodes { ... }, along with pageInfo.
+type GraphQLConnection[T any] struct {
+	Count    int              `json:"count"`
+	Edges    []GraphQLEdge[T] `json:"edges"`
+	Nodes    []T              `json:"nodes"`
+	PageInfo GraphQLPageInfo  `json:"pageInfo"`
+}
+
+// Items returns the connection's nodes, from Nodes or Edges, whichever the
+// query selected.
+func (c GraphQLConnection[T]) Items() []T {
+	if len(c.Nodes) > 0 {
+		return c.Nodes
+	}
+
+	items := make([]T, len(c.Edges))
+	for i, edge := range c.Edges {
+		items[i] = edge.Node
+	}
+	return items
+}
+
+// GraphQLAll returns an iterator over every node of a GraphQL connection,
+// fetching each page as iteration reaches it. Each response is decoded into a
+// D, and connection returns the connection to follow from it, or nil if there
+// is none.
+//
+// The query must take an $after: String variable, pass it to the connection,
+// and select the connection's pageInfo { hasNextPage endCursor }. For example:
+//
+//	const query = `query($org: ID!, $after: String) {
+//		organization(slug: $org) {
+//			pipelines(first: 100, after: $after) {
+//				edges { node { name slug } }
+//				pageInfo { hasNextPage endCursor }
+//			}
+//		}
+//	}`
+//
+//	type pipeline struct{ Name, Slug string }
+//	type data struct {
+//		Organization struct {
+//			Pipelines buildkite.GraphQLConnection[pipeline]
+//		}
+//	}
+//
+//	pipelines := buildkite.GraphQLAll(ctx, client.GraphQL, query, map[string]any{"org": "acme"},
+//		func(d *data) *buildkite.GraphQLConnection[pipeline] { return &d.Organization.Pipelines })
+//	for p, err := range pipelines {
+//		...
+//	}
+//
+// Iteration stops after yielding an error, which is either the error returned
+// by GraphQLService.Do or the context's error if ctx is done before a page is
+// fetched. vars is copied before the first request.
+func GraphQLAll[T any, D any](ctx context.Context, gs *GraphQLService, query string, vars map[string]any, connection func(*D) *GraphQLConnection[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pageVars := maps.Clone(vars)
+		if pageVars == nil {
+			pageVars = map[string]any{}
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var data D
+			if _, err := gs.Do(ctx, query, pageVars, &data); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			conn := connection(&data)
+			if conn == nil {
+				return
+			}
+
+			for _, item := range conn.Items() {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == "" {
+				return
+			}
+			pageVars["after"] = conn.PageInfo.EndCursor
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGraphQLService_Do(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	client.authHeader = "Bearer abc123"
+
+	ms.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Authorization"); got != "Bearer abc123" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer abc123")
+		}
+		if got := r.Header.Get("User-Agent"); got != client.UserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, client.UserAgent)
+		}
+		assertRequestJSON(t, r, `{"query":"query($slug: ID!) { pipeline(slug: $slug) { name } }","variables":{"slug":"acme/web"}}`)
+
+		_, _ = w.Write([]byte(`{"data":{"pipeline":{"name":"Web"}}}`))
+	})
+
+	client.GraphQLURL = must(client.BaseURL.Parse("/graphql"))
+
+	var out struct {
+		Pipeline struct {
+			Name string `json:"name"`
+		} `json:"pipeline"`
+	}
+	_, err := client.GraphQL.Do(context.Background(), "query($slug: ID!) { pipeline(slug: $slug) { name } }", map[string]any{"slug": "acme/web"}, &out)
+	if err != nil {
+		t.Fatalf("GraphQL.Do returned error: %v", err)
+	}
+	if out.Pipeline.Name != "Web" {
+		t.Errorf("pipeline name = %q, want %q", out.Pipeline.Name, "Web")
+	}
+}
+
+func TestGraphQLService_Do_Errors(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	client.GraphQLURL = must(client.BaseURL.Parse("/graphql"))
+
+	ms.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"data": {"viewer": {"user": {"name": "Keith"}, "secret": null}},
+			"errors": [{
+				"message": "Not allowed",
+				"locations": [{"line": 1, "column": 31}],
+				"path": ["viewer", "secret"],
+				"extensions": {"code": "FORBIDDEN"}
+			}]
+		}`))
+	})
+
+	var out struct {
+		Viewer struct {
+			User struct {
+				Name string `json:"name"`
+			} `json:"user"`
+		} `json:"viewer"`
+	}
+	_, err := client.GraphQL.Do(context.Background(), "{ viewer { user { name } secret } }", nil, &out)
+
+	var gqlErrs GraphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		t.Fatalf("GraphQL.Do error = %v, want GraphQLErrors", err)
+	}
+	want := GraphQLErrors{{
+		Message:    "Not allowed",
+		Locations:  []GraphQLErrorLocation{{Line: 1, Column: 31}},
+		Path:       []any{"viewer", "secret"},
+		Extensions: map[string]any{"code": "FORBIDDEN"},
+	}}
+	if diff := cmp.Diff(want, gqlErrs); diff != "" {
+		t.Errorf("GraphQLErrors diff: (-want +got)\n%s", diff)
+	}
+	if got, want := err.Error(), "graphql: viewer.secret: Not allowed"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if out.Viewer.User.Name != "Keith" {
+		t.Errorf("partial data name = %q, want %q", out.Viewer.User.Name, "Keith")
+	}
+}
+
+func TestGraphQLService_Do_RetriesQueries(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+	client.GraphQLURL = must(client.BaseURL.Parse("/graphql"))
+	client.retryPolicy = RetryPolicy{StatusCodes: []int{http.StatusBadGateway}}
+
+	calls := 0
+	ms.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "query", query: `query { viewer { user { name } } }`, want: 1 + client.maxRetries},
+		{name: "mutation", query: `mutation { buildCancel(input: {id: "1"}) { clientMutationId } }`, want: 1},
+	}
+
+	for _, tt := range tests {
+		calls = 0
+		_, err := client.GraphQL.Do(context.Background(), tt.query, nil, nil)
+		if err == nil {
+			t.Errorf("%s: GraphQL.Do returned no error", tt.name)
+		}
+		if calls != tt.want {
+			t.Errorf("%s: server calls = %d, want %d", tt.name, calls, tt.want)
+		}
+	}
+}
+
+func TestIsGraphQLMutation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{query: `{ viewer { user { name } } }`, want: false},
+		{query: `query Viewer { viewer { user { name } } }`, want: false},
+		{query: `query { pipeline(slug: "mutation { x }") { name } }`, want: false},
+		{query: "# mutation\nquery { viewer { id } }", want: false},
+		{query: `query { a(x: """ " } mutation { """) { id } }`, want: false},
+		{query: `query { a(x: """ " { """) { id } } mutation { b { id } }`, want: true},
+		{query: `query { a(x: """ \""" { """) { id } } mutation { b { id } }`, want: true},
+		{query: "query { a(x: \"\"\"\n  {\n\"\"\") { id } }\nmutation { b { id } }", want: true},
+		{query: `mutation Cancel($id: ID!) { buildCancel(input: {id: $id}) { build { id } } }`, want: true},
+		{query: `fragment F on Build { id } mutation { buildCancel(input: {id: "1"}) { build { ...F } } }`, want: true},
+		{query: `subscription { buildUpdated { id } }`, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := isGraphQLMutation(tt.query); got != tt.want {
+			t.Errorf("isGraphQLMutation(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestGraphQLAll(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	client.GraphQLURL = must(client.BaseURL.Parse("/graphql"))
+
+	pages := map[string]string{
+		"":   `{"data":{"organization":{"pipelines":{"edges":[{"node":{"slug":"a"}},{"node":{"slug":"b"}}],"pageInfo":{"hasNextPage":true,"endCursor":"c2"}}}}}`,
+		"c2": `{"data":{"organization":{"pipelines":{"edges":[{"node":{"slug":"c"}}],"pageInfo":{"hasNextPage":false,"endCursor":"c3"}}}}}`,
+	}
+	ms.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		if body.Variables["org"] != "acme" {
+			t.Errorf("org variable = %v, want acme", body.Variables["org"])
+		}
+		after, _ := body.Variables["after"].(string)
+		_, _ = w.Write([]byte(pages[after]))
+	})
+
+	type pipeline struct {
+		Slug string `json:"slug"`
+	}
+	type data struct {
+		Organization struct {
+			Pipelines GraphQLConnection[pipeline] `json:"pipelines"`
+		} `json:"organization"`
+	}
+
+	vars := map[string]any{"org": "acme"}
+	var got []string
+	for p, err := range GraphQLAll(context.Background(), client.GraphQL, "query($org: ID!, $after: String) { ... }", vars,
+		func(d *data) *GraphQLConnection[pipeline] { return &d.Organization.Pipelines }) {
+		if err != nil {
+			t.Fatalf("GraphQLAll error: %v", err)
+		}
+		got = append(got, p.Slug)
+	}
+
+	if diff := cmp.Diff([]string{"a", "b", "c"}, got); diff != "" {
+		t.Errorf("slugs diff: (-want +got)\n%s", diff)
+	}
+	if _, ok := vars["after"]; ok {
+		t.Errorf("GraphQLAll modified the caller's vars: %v", vars)
+	}
+}
@@ -0,0 +1,458 @@
+// Command genapi generates the service interfaces in api_gen.go and the fakes
+// in buildkitefake/fakes_gen.go from the service types of the buildkite
+// package. Run it with go generate from the repository root.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the buildkite package")
+	flag.Parse()
+
+	files, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		//nolint:gosec // G306: generated source files are readable like the rest of the tree
+		if err := os.WriteFile(filepath.Join(*dir, name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// service is a service type of the buildkite package.
+type service struct {
+	Field   string // name of its Client field, e.g. "Builds"
+	Type    string // e.g. "BuildsService"
+	Methods []method
+}
+
+// Name is the service's name without the Service suffix, e.g. "Builds".
+func (s service) Name() string {
+	return strings.TrimSuffix(s.Type, "Service")
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []ast.Expr
+}
+
+type param struct {
+	Name string
+	Type ast.Expr
+}
+
+// generate returns the generated files, keyed by their paths relative to dir.
+func generate(dir string) (map[string][]byte, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_gen.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	services, imports, err := collect(files)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := apiFile(fset, services, imports)
+	if err != nil {
+		return nil, fmt.Errorf("api_gen.go: %w", err)
+	}
+	fakes, err := fakesFile(fset, services, imports)
+	if err != nil {
+		return nil, fmt.Errorf("fakes_gen.go: %w", err)
+	}
+
+	return map[string][]byte{
+		"api_gen.go":                 api,
+		"buildkitefake/fakes_gen.go": fakes,
+	}, nil
+}
+
+// collect finds the services on Client and their exported methods, and the
+// import paths of the packages their signatures refer to, keyed by name.
+func collect(files []*ast.File) ([]service, map[string]string, error) {
+	var services []service
+	byType := map[string]*service{}
+	imports := map[string]string{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if ts.Name.Name != "Client" || !ok {
+					continue
+				}
+				for _, f := range st.Fields.List {
+					star, ok := f.Type.(*ast.StarExpr)
+					if !ok {
+						continue
+					}
+					ident, ok := star.X.(*ast.Ident)
+					if !ok || !strings.HasSuffix(ident.Name, "Service") {
+						continue
+					}
+					for _, n := range f.Names {
+						services = append(services, service{Field: n.Name, Type: ident.Name})
+					}
+				}
+			}
+		}
+	}
+	if len(services) == 0 {
+		return nil, nil, fmt.Errorf("no services found on Client")
+	}
+	for i := range services {
+		byType[services[i].Type] = &services[i]
+	}
+
+	for _, file := range files {
+		fileImports := map[string]string{}
+		for _, imp := range file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			local := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				local = imp.Name.Name
+			}
+			fileImports[local] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			ident, ok := star.X.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			svc, ok := byType[ident.Name]
+			if !ok {
+				continue
+			}
+
+			m := method{Name: fn.Name.Name}
+			for i, f := range fn.Type.Params.List {
+				names := f.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+				}
+				for _, n := range names {
+					m.Params = append(m.Params, param{Name: n.Name, Type: f.Type})
+				}
+			}
+			if fn.Type.Results != nil {
+				for _, f := range fn.Type.Results.List {
+					for range max(1, len(f.Names)) {
+						m.Results = append(m.Results, f.Type)
+					}
+				}
+			}
+			svc.Methods = append(svc.Methods, m)
+
+			ast.Inspect(fn.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if x, ok := sel.X.(*ast.Ident); ok {
+						if path, ok := fileImports[x.Name]; ok {
+							imports[x.Name] = path
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	for i := range services {
+		slices.SortFunc(services[i].Methods, func(a, b method) int { return strings.Compare(a.Name, b.Name) })
+	}
+	slices.SortFunc(services, func(a, b service) int { return strings.Compare(a.Name(), b.Name()) })
+	return services, imports, nil
+}
+
+const header = "// Code generated by internal/cmd/genapi; DO NOT EDIT.\n\n"
+
+func apiFile(fset *token.FileSet, services []service, imports map[string]string) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package buildkite\n\n")
+	writeImports(&b, services, imports, nil)
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %sAPI is the interface implemented by %s.\n", s.Name(), s.Type)
+		fmt.Fprintf(&b, "type %sAPI interface {\n", s.Name())
+		for _, m := range s.Methods {
+			fmt.Fprintf(&b, "\t%s%s\n", m.Name, signature(fset, m, nil))
+		}
+		b.WriteString("}\n\n")
+		fmt.Fprintf(&b, "var _ %sAPI = (*%s)(nil)\n\n", s.Name(), s.Type)
+	}
+
+	b.WriteString("// ClientAPI is the interface implemented by Client, giving access to each of\n// its services through their interfaces. See the buildkitefake package.\n")
+	b.WriteString("type ClientAPI interface {\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\t%sAPI() %sAPI\n", s.Name(), s.Name())
+	}
+	b.WriteString("}\n\nvar _ ClientAPI = (*Client)(nil)\n\n")
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %sAPI returns c.%s.\n", s.Name(), s.Field)
+		fmt.Fprintf(&b, "func (c *Client) %sAPI() %sAPI {\n\treturn c.%s\n}\n\n", s.Name(), s.Name(), s.Field)
+	}
+
+	return format.Source(b.Bytes())
+}
+
+func fakesFile(fset *token.FileSet, services []service, imports map[string]string) ([]byte, error) {
+	qualify := func(name string) string { return "buildkite." + name }
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package buildkitefake\n\n")
+	writeImports(&b, services, imports, qualify)
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %s is a fake buildkite.%sAPI.\n", s.Name(), s.Name())
+		fmt.Fprintf(&b, "type %s struct {\n", s.Name())
+		for _, m := range s.Methods {
+			fmt.Fprintf(&b, "\t%sFunc func%s\n", m.Name, signature(fset, m, qualify))
+		}
+		b.WriteString("}\n\n")
+		fmt.Fprintf(&b, "var _ buildkite.%sAPI = (*%s)(nil)\n\n", s.Name(), s.Name())
+
+		for _, m := range s.Methods {
+			recv := "f"
+			for _, p := range m.Params {
+				if p.Name == recv {
+					recv = "fake"
+				}
+			}
+
+			args := make([]string, len(m.Params))
+			for i, p := range m.Params {
+				args[i] = p.Name
+				if _, ok := p.Type.(*ast.Ellipsis); ok {
+					args[i] += "..."
+				}
+			}
+
+			fmt.Fprintf(&b, "// %s calls %sFunc.\n", m.Name, m.Name)
+			fmt.Fprintf(&b, "func (%s *%s) %s%s {\n", recv, s.Name(), m.Name, signature(fset, m, qualify))
+			fmt.Fprintf(&b, "\tif %s.%sFunc == nil {\n", recv, m.Name)
+			notImpl := fmt.Sprintf("notImplemented(%q)", s.Name()+"."+m.Name)
+			if err := writeNotImplemented(&b, fset, m, notImpl, qualify); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", s.Type, m.Name, err)
+			}
+			b.WriteString("\t}\n")
+			fmt.Fprintf(&b, "\treturn %s.%sFunc(%s)\n}\n\n", recv, m.Name, strings.Join(args, ", "))
+		}
+	}
+
+	b.WriteString("// Client is a fake buildkite.ClientAPI made up of a fake for each service.\n")
+	b.WriteString("// Use NewClient to create one with every fake allocated.\n")
+	b.WriteString("type Client struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\t%s *%s\n", s.Name(), s.Name())
+	}
+	b.WriteString("}\n\nvar _ buildkite.ClientAPI = (*Client)(nil)\n\n")
+
+	b.WriteString("// NewClient returns a Client whose service fakes are allocated, with no\n// functions set.\n")
+	b.WriteString("func NewClient() *Client {\n\treturn &Client{\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "\t\t%s: &%s{},\n", s.Name(), s.Name())
+	}
+	b.WriteString("\t}\n}\n\n")
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "// %sAPI returns c.%s.\n", s.Name(), s.Name())
+		fmt.Fprintf(&b, "func (c *Client) %sAPI() buildkite.%sAPI {\n\treturn c.%s\n}\n\n", s.Name(), s.Name(), s.Name())
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// writeNotImplemented writes the statement returning the zero results of m
+// along with err, or an iterator yielding err.
+func writeNotImplemented(b *bytes.Buffer, fset *token.FileSet, m method, err string, qualify func(string) string) error {
+	if len(m.Results) == 0 {
+		return fmt.Errorf("no results")
+	}
+
+	last := m.Results[len(m.Results)-1]
+	if ident, ok := last.(*ast.Ident); ok && ident.Name == "error" {
+		results := make([]string, len(m.Results))
+		for i, r := range m.Results[:len(m.Results)-1] {
+			fmt.Fprintf(b, "\t\tvar r%d %s\n", i, expr(fset, r, qualify))
+			results[i] = fmt.Sprintf("r%d", i)
+		}
+		results[len(results)-1] = err
+		fmt.Fprintf(b, "\t\treturn %s\n", strings.Join(results, ", "))
+		return nil
+	}
+
+	// iter.Seq2[T, error]
+	if idx, ok := last.(*ast.IndexListExpr); ok && len(m.Results) == 1 && len(idx.Indices) == 2 {
+		if sel, ok := idx.X.(*ast.SelectorExpr); ok && expr(fset, sel, nil) == "iter.Seq2" {
+			elem := expr(fset, idx.Indices[0], qualify)
+			fmt.Fprintf(b, "\t\treturn func(yield func(%s, error) bool) {\n", elem)
+			fmt.Fprintf(b, "\t\t\tvar zero %s\n\t\t\tyield(zero, %s)\n\t\t}\n", elem, err)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("results end in neither error nor iter.Seq2[T, error]")
+}
+
+func writeImports(b *bytes.Buffer, services []service, imports map[string]string, qualify func(string) string) {
+	used := map[string]bool{}
+	for _, s := range services {
+		for _, m := range s.Methods {
+			var exprs []ast.Expr
+			for _, p := range m.Params {
+				exprs = append(exprs, p.Type)
+			}
+			exprs = append(exprs, m.Results...)
+			for _, e := range exprs {
+				ast.Inspect(e, func(n ast.Node) bool {
+					if sel, ok := n.(*ast.SelectorExpr); ok {
+						if x, ok := sel.X.(*ast.Ident); ok {
+							used[x.Name] = true
+						}
+					}
+					return true
+				})
+			}
+		}
+	}
+
+	var paths []string
+	for name := range used {
+		if path, ok := imports[name]; ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	b.WriteString("import (\n")
+	for _, p := range paths {
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	if qualify != nil {
+		b.WriteString("\n\t\"github.com/buildkite/go-buildkite/v5\"\n")
+	}
+	b.WriteString(")\n\n")
+}
+
+// signature returns m's parameters and results as source.
+func signature(fset *token.FileSet, m method, qualify func(string) string) string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Name + " " + expr(fset, p.Type, qualify)
+	}
+	results := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		results[i] = expr(fset, r, qualify)
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// expr returns e as source, with the exported identifiers of the buildkite
+// package passed through qualify if it is set.
+func expr(fset *token.FileSet, e ast.Expr, qualify func(string) string) string {
+	if qualify != nil {
+		e = qualifyExpr(e, qualify)
+	}
+	var b bytes.Buffer
+	_ = printer.Fprint(&b, fset, e)
+	return b.String()
+}
+
+func qualifyExpr(e ast.Expr, qualify func(string) string) ast.Expr {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return ast.NewIdent(qualify(e.Name))
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualifyExpr(e.X, qualify)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualifyExpr(e.Elt, qualify)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualifyExpr(e.Key, qualify), Value: qualifyExpr(e.Value, qualify)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualifyExpr(e.Elt, qualify)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualifyExpr(e.X, qualify), Index: qualifyExpr(e.Index, qualify)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, idx := range e.Indices {
+			indices[i] = qualifyExpr(idx, qualify)
+		}
+		return &ast.IndexListExpr{X: qualifyExpr(e.X, qualify), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params, qualify), Results: qualifyFields(e.Results, qualify)}
+	default:
+		// Selector expressions refer to other packages, and are left as is.
+		return e
+	}
+}
+
+func qualifyFields(fl *ast.FieldList, qualify func(string) string) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		out.List = append(out.List, &ast.Field{Names: f.Names, Type: qualifyExpr(f.Type, qualify)})
+	}
+	return out
+}
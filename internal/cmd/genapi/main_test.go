@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerate_UpToDate fails when a service method has been added or changed
+// without running go generate.
+func TestGenerate_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join("..", "..", "..")
+	files, err := generate(dir)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate in the repository root", name)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package redact removes secrets from HTTP headers and JSON bodies exchanged
+// with the Buildkite API, for logging and recording them.
+package redact
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces secret header and field values.
+const Redacted = "[REDACTED]"
+
+// secretHeaders are always redacted from headers.
+var secretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// secretFields are JSON object keys whose values are always redacted,
+// wherever they appear. These cover agent, cluster and package registry
+// tokens, access tokens, and test suite API tokens.
+var secretFields = map[string]bool{
+	"access_token": true,
+	"api_token":    true,
+	"token":        true,
+	"password":     true,
+	"secret":       true,
+}
+
+// Header returns a copy of h with secret headers redacted.
+func Header(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range secretHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// IsSecretField reports whether the value of the JSON object key or query
+// parameter named key is always secret.
+func IsSecretField(key string) bool {
+	return secretFields[key]
+}
+
+// ValueIsSecret reports whether "value" fields are secret in bodies sent to
+// or from path. Cluster secret values are only secret on the secrets
+// endpoints; elsewhere "value" holds things like step signatures and rule
+// definitions.
+func ValueIsSecret(path string) bool {
+	return strings.Contains(path, "/secrets")
+}
+
+// JSON replaces the values of secret fields in a decoded JSON value, in
+// place, and returns it. "value" fields are also replaced if redactValue is
+// set.
+func JSON(v any, redactValue bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if secretFields[k] || (redactValue && k == "value") {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = JSON(field, redactValue)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = JSON(item, redactValue)
+		}
+	}
+	return v
+}
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -20,12 +21,12 @@ type JobsService struct {
 type Job struct {
 	ID                 string          `json:"id,omitempty"`
 	GraphQLID          string          `json:"graphql_id,omitempty"`
-	Type               string          `json:"type,omitempty"`
+	Type               JobType         `json:"type,omitempty"`
 	Name               string          `json:"name,omitempty"`
 	Label              string          `json:"label,omitempty"`
 	StepKey            string          `json:"step_key,omitempty"`
 	GroupKey           string          `json:"group_key,omitempty"`
-	State              string          `json:"state,omitempty"`
+	State              JobState        `json:"state,omitempty"`
 	BuildURL           string          `json:"build_url,omitempty"`
 	LogURL             string          `json:"log_url,omitempty"`
 	LogsURL            string          `json:"logs_url,omitempty"`
@@ -141,13 +142,13 @@ type StepInfo struct {
 
 // JobsListOptions specifies the optional parameters to the JobsService.ListByBuild method.
 type JobsListOptions struct {
-	State              []string `url:"state,brackets,omitempty"`
-	StepKey            string   `url:"step_key,omitempty"`
-	GroupKey           string   `url:"group_key,omitempty"`
-	IncludeRetriedJobs *bool    `url:"include_retried_jobs,omitempty"`
-	PerPage            int      `url:"per_page,omitempty"`
-	After              string   `url:"after,omitempty"`
-	Before             string   `url:"before,omitempty"`
+	State              []JobState `url:"state,brackets,omitempty"`
+	StepKey            string     `url:"step_key,omitempty"`
+	GroupKey           string     `url:"group_key,omitempty"`
+	IncludeRetriedJobs *bool      `url:"include_retried_jobs,omitempty"`
+	PerPage            int        `url:"per_page,omitempty"`
+	After              string     `url:"after,omitempty"`
+	Before             string     `url:"before,omitempty"`
 }
 
 type JobsListLink string
@@ -160,8 +161,13 @@ func (l JobsListLink) ToOptions() (*JobsListOptions, error) {
 
 	q := u.Query()
 
+	var states []JobState
+	for _, s := range q["state[]"] {
+		states = append(states, JobState(s))
+	}
+
 	opts := &JobsListOptions{
-		State:    q["state[]"],
+		State:    states,
 		StepKey:  q.Get("step_key"),
 		GroupKey: q.Get("group_key"),
 		After:    q.Get("after"),
@@ -222,6 +228,18 @@ func (js *JobsService) ListByBuild(ctx context.Context, org string, pipeline str
 	return jobs, resp, err
 }
 
+// ListByBuildAll is like ListByBuild but returns an iterator over the jobs on
+// every page, following the cursor links as iteration reaches the end of each
+// page. Iteration moves forward through Links.Next, or backward through
+// Links.Previous when opt.Before is set.
+func (js *JobsService) ListByBuildAll(ctx context.Context, org, pipeline, buildNumber string, opt *JobsListOptions) iter.Seq2[Job, error] {
+	backward := opt != nil && opt.Before != ""
+	return allCursorPages(ctx, opt, backward, func(ctx context.Context, opt *JobsListOptions) ([]Job, JobsListLink, JobsListLink, error) {
+		jobs, _, err := js.ListByBuild(ctx, org, pipeline, buildNumber, opt)
+		return jobs.Items, jobs.Links.Previous, jobs.Links.Next, err
+	})
+}
+
 // GetJob returns a single job for a specific build.
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/jobs#get-a-job
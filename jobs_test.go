@@ -120,7 +120,7 @@ func TestJobsService_ListByBuild_WithOptions(t *testing.T) {
 
 	includeRetriedJobs := false
 	opt := &JobsListOptions{
-		State:              []string{"passed", "failed"},
+		State:              []JobState{JobStatePassed, JobStateFailed},
 		StepKey:            "test",
 		GroupKey:           "tests",
 		IncludeRetriedJobs: &includeRetriedJobs,
@@ -133,6 +133,54 @@ func TestJobsService_ListByBuild_WithOptions(t *testing.T) {
 	}
 }
 
+func TestJobsService_ListByBuildAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	const base = "https://api.buildkite.com/v2/organizations/my-great-org/pipelines/sup-keith/builds/123/jobs"
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/123/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		if got := q.Get("step_key"); got != "test" {
+			t.Errorf("step_key = %q, want %q", got, "test")
+		}
+
+		switch {
+		case q.Get("after") == "" && q.Get("before") == "":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"job-1"},{"id":"job-2"}],"links":{"next":"%s?step_key=test&after=cursor-2"}}`, base)
+		case q.Get("after") == "cursor-2":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"job-3"}],"links":{"prev":"%s?step_key=test&before=cursor-3"}}`, base)
+		case q.Get("before") == "cursor-3":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"job-2"},{"id":"job-1"}],"links":{"next":"%s?step_key=test&after=cursor-2"}}`, base)
+		default:
+			t.Fatalf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	collect := func(opt *JobsListOptions) []string {
+		var ids []string
+		for job, err := range client.Jobs.ListByBuildAll(context.Background(), "my-great-org", "sup-keith", "123", opt) {
+			if err != nil {
+				t.Fatalf("ListByBuildAll returned error: %v", err)
+			}
+			ids = append(ids, job.ID)
+		}
+		return ids
+	}
+
+	if diff := cmp.Diff(collect(&JobsListOptions{StepKey: "test"}), []string{"job-1", "job-2", "job-3"}); diff != "" {
+		t.Errorf("ListByBuildAll forward diff: (-got +want)\n%s", diff)
+	}
+
+	if diff := cmp.Diff(collect(&JobsListOptions{StepKey: "test", Before: "cursor-3"}), []string{"job-2", "job-1"}); diff != "" {
+		t.Errorf("ListByBuildAll backward diff: (-got +want)\n%s", diff)
+	}
+}
+
 func TestJobsListLink_ToOptions(t *testing.T) {
 	t.Parallel()
 
@@ -144,7 +192,7 @@ func TestJobsListLink_ToOptions(t *testing.T) {
 
 	includeRetriedJobs := false
 	want := &JobsListOptions{
-		State:              []string{"passed", "failed"},
+		State:              []JobState{JobStatePassed, JobStateFailed},
 		StepKey:            "test",
 		GroupKey:           "tests",
 		IncludeRetriedJobs: &includeRetriedJobs,
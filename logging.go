@@ -0,0 +1,141 @@
+package buildkite
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
+)
+
+// maxLoggedBodyBytes caps how much of a request or response body is logged.
+// Longer bodies are omitted.
+const maxLoggedBodyBytes = 64 << 10
+
+// WithLogger configures the buildkite.Client to emit structured debug events
+// for every HTTP attempt it makes, including retries. Request events carry the
+// method, path and 1-based attempt number; response events add the status and
+// duration, and failed round trips add the error. Headers and bodies are only
+// included when enabled with WithLogBodies.
+//
+// Authorization headers and known secret fields, such as cluster secret values
+// and registry tokens, are always redacted. Passing nil disables logging.
+func WithLogger(logger *slog.Logger) ClientOpt {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithLogBodies configures whether the logger set by WithLogger also records
+// request and response headers and JSON bodies. Secret values are redacted,
+// bodies that are not JSON are logged by size only, and bodies larger than
+// 64KiB are omitted.
+func WithLogBodies(enabled bool) ClientOpt {
+	return func(c *Client) error {
+		c.logBodies = enabled
+		return nil
+	}
+}
+
+// logRequest logs an attempt to send req.
+func (c *Client) logRequest(req *http.Request, attempt int) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+	}
+
+	if c.logBodies {
+		attrs = append(attrs, headerAttr(req.Header))
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, err := io.ReadAll(io.LimitReader(body, maxLoggedBodyBytes+1))
+				_ = body.Close()
+				if err == nil {
+					attrs = append(attrs, bodyAttr(req.URL.Path, req.Header, data))
+				}
+			}
+		}
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelDebug, "buildkite request", attrs...)
+}
+
+// logResponse logs the response to an attempt to send req. When bodies are
+// logged, resp.Body is replaced so that what was read can still be decoded.
+func (c *Client) logResponse(req *http.Request, resp *http.Response, attempt int, elapsed time.Duration) {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("duration", elapsed),
+	}
+
+	if c.logBodies {
+		attrs = append(attrs, headerAttr(resp.Header))
+
+		// Read no more than is logged, then stitch what was read back in front
+		// of the rest of the body so large downloads are not buffered.
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodyBytes+1))
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+		if err == nil {
+			attrs = append(attrs, bodyAttr(req.URL.Path, resp.Header, data))
+		}
+	}
+
+	c.logger.LogAttrs(req.Context(), slog.LevelDebug, "buildkite response", attrs...)
+}
+
+// logError logs an attempt to send req that failed without a response.
+func (c *Client) logError(req *http.Request, err error, attempt int, elapsed time.Duration) {
+	c.logger.LogAttrs(req.Context(), slog.LevelDebug, "buildkite request failed",
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", elapsed),
+		slog.Any("error", err),
+	)
+}
+
+// headerAttr returns h as a log attribute with secret headers redacted.
+func headerAttr(h http.Header) slog.Attr {
+	return slog.Any("headers", redact.Header(h))
+}
+
+// bodyAttr returns data as a log attribute. JSON bodies are logged with secret
+// fields redacted, anything else is logged by size only, and bodies over
+// maxLoggedBodyBytes are omitted.
+func bodyAttr(path string, h http.Header, data []byte) slog.Attr {
+	if len(data) == 0 {
+		return slog.String("body", "")
+	}
+
+	if len(data) > maxLoggedBodyBytes {
+		return slog.String("body", "[OMITTED]")
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(h.Get("Content-Type"))
+	if mediaType != "" && mediaType != "application/json" {
+		return slog.Int("body_bytes", len(data))
+	}
+
+	var body any
+	if err := json.Unmarshal(data, &body); err != nil {
+		return slog.Int("body_bytes", len(data))
+	}
+
+	out, err := json.Marshal(redact.JSON(body, redact.ValueIsSecret(path)))
+	if err != nil {
+		return slog.Int("body_bytes", len(data))
+	}
+	return slog.String("body", string(out))
+}
@@ -0,0 +1,244 @@
+package buildkite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/buildkite/go-buildkite/v5/internal/redact"
+)
+
+// logRecords decodes the JSON lines written by a slog.JSONHandler.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func newLoggingTestClient(t *testing.T, bodies bool) (*mockServer, *Client, *bytes.Buffer) {
+	t.Helper()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	for _, opt := range []ClientOpt{WithTokenAuth("super-secret-token"), WithLogger(logger), WithLogBodies(bodies)} {
+		if err := opt(client); err != nil {
+			t.Fatalf("applying client option: %v", err)
+		}
+	}
+
+	return server, client, buf
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	server, client, buf := newLoggingTestClient(t, false)
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	if _, _, err := client.Pipelines.List(context.Background(), "my-great-org", nil); err != nil {
+		t.Fatalf("Pipelines.List returned error: %v", err)
+	}
+
+	records := logRecords(t, buf)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 log records, got %d: %s", len(records), buf)
+	}
+
+	req, resp := records[0], records[1]
+	if req["msg"] != "buildkite request" || resp["msg"] != "buildkite response" {
+		t.Errorf("unexpected messages %q, %q", req["msg"], resp["msg"])
+	}
+	for _, record := range records {
+		if record["method"] != "GET" || record["path"] != "/v2/organizations/my-great-org/pipelines" || record["attempt"] != float64(1) {
+			t.Errorf("unexpected request attributes in %v", record)
+		}
+		if _, ok := record["headers"]; ok {
+			t.Errorf("headers logged without WithLogBodies: %v", record)
+		}
+	}
+	if resp["status"] != float64(http.StatusOK) {
+		t.Errorf("response status = %v, want 200", resp["status"])
+	}
+	if _, ok := resp["duration"]; !ok {
+		t.Errorf("response record missing duration: %v", resp)
+	}
+}
+
+func TestWithLogger_AttemptNumbers(t *testing.T) {
+	t.Parallel()
+
+	server, client, buf := newLoggingTestClient(t, false)
+	client.sleepFunc = func(time.Duration) {}
+
+	callCount := 0
+	server.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if callCount == 1 {
+			w.Header().Set("RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, record := range logRecords(t, buf) {
+		got = append(got, fmt.Sprintf("%v %v %v", record["msg"], record["attempt"], record["status"]))
+	}
+	want := []string{
+		"buildkite request 1 <nil>",
+		"buildkite response 1 429",
+		"buildkite request 2 <nil>",
+		"buildkite response 2 200",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("log records:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWithLogBodies_RedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	server, client, buf := newLoggingTestClient(t, true)
+
+	server.HandleFunc("/v2/organizations/my-great-org/clusters/cluster-1/secrets/secret-1/value", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		_, _ = fmt.Fprint(w, `{"id":"secret-1","key":"DEPLOY_KEY"}`)
+	})
+	server.HandleFunc("/v2/organizations/my-great-org/clusters/cluster-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"id":"token-1","description":"ci","token":"bkct_very-secret"}`)
+	})
+
+	if _, err := client.ClusterSecrets.UpdateValue(context.Background(), "my-great-org", "cluster-1", "secret-1", ClusterSecretValueUpdate{Value: "hunter2"}); err != nil {
+		t.Fatalf("ClusterSecrets.UpdateValue returned error: %v", err)
+	}
+	token, _, err := client.ClusterTokens.Create(context.Background(), "my-great-org", "cluster-1", ClusterTokenCreate{Description: "ci"})
+	if err != nil {
+		t.Fatalf("ClusterTokens.Create returned error: %v", err)
+	}
+	if token.Token != "bkct_very-secret" {
+		t.Errorf("response body was not preserved for decoding, got token %q", token.Token)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"super-secret-token", "hunter2", "bkct_very-secret", "session=abc"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output contains secret %q:\n%s", secret, out)
+		}
+	}
+	for _, visible := range []string{"DEPLOY_KEY", `\"description\":\"ci\"`, redact.Redacted} {
+		if !strings.Contains(out, visible) {
+			t.Errorf("log output missing %q:\n%s", visible, out)
+		}
+	}
+}
+
+func TestWithLogBodies_RedactsTestSuiteAPIToken(t *testing.T) {
+	t.Parallel()
+
+	server, client, buf := newLoggingTestClient(t, true)
+
+	server.HandleFunc("/v2/analytics/organizations/my-great-org/suites", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"id":"suite-1","slug":"rspec","name":"RSpec","api_token":"suite-very-secret"}`)
+	})
+
+	if _, _, err := client.TestSuites.Create(context.Background(), "my-great-org", TestSuiteCreate{Name: "RSpec", ShowAPIToken: true}); err != nil {
+		t.Fatalf("TestSuites.Create returned error: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "suite-very-secret") {
+		t.Errorf("log output contains the suite API token:\n%s", out)
+	}
+	for _, visible := range []string{`\"slug\":\"rspec\"`, `\"api_token\":\"` + redact.Redacted} {
+		if !strings.Contains(out, visible) {
+			t.Errorf("log output missing %q:\n%s", visible, out)
+		}
+	}
+}
+
+func TestBodyAttr(t *testing.T) {
+	t.Parallel()
+
+	jsonHeader := http.Header{"Content-Type": {"application/json"}}
+
+	tests := []struct {
+		name   string
+		path   string
+		header http.Header
+		body   string
+		want   string
+	}{
+		{
+			name:   "value kept outside secrets endpoints",
+			path:   "/v2/organizations/org/rules",
+			header: jsonHeader,
+			body:   `{"value":{"source_pipeline":"a"}}`,
+			want:   `body={"value":{"source_pipeline":"a"}}`,
+		},
+		{
+			name:   "nested tokens redacted",
+			path:   "/v2/organizations/org/agents",
+			header: jsonHeader,
+			body:   `[{"access_token":"abc","name":"agent"}]`,
+			want:   `body=[{"access_token":"[REDACTED]","name":"agent"}]`,
+		},
+		{
+			name:   "non-JSON logged by size",
+			path:   "/v2/organizations/org/pipelines/p/builds/1/jobs/j/log",
+			header: http.Header{"Content-Type": {"text/plain"}},
+			body:   "hello",
+			want:   "body_bytes=5",
+		},
+		{
+			name:   "oversized body omitted",
+			path:   "/v2/organizations/org/pipelines",
+			header: jsonHeader,
+			body:   `"` + strings.Repeat("a", maxLoggedBodyBytes) + `"`,
+			want:   "body=[OMITTED]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bodyAttr(tt.path, tt.header, []byte(tt.body)).String(); got != tt.want {
+				t.Errorf("bodyAttr() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -51,6 +52,14 @@ func (ms *MembersService) List(ctx context.Context, org string, opt *MemberListO
 	return members, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (ms *MembersService) ListAll(ctx context.Context, org string, opt *MemberListOptions) iter.Seq2[Member, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *MemberListOptions) ([]Member, *Response, error) {
+		return ms.List(ctx, org, opt)
+	})
+}
+
 // Get a specific member of a Buildkite organization
 //
 // https://buildkite.com/docs/apis/rest-api/organizations/members#get-an-organization-member
@@ -0,0 +1,57 @@
+package buildkite
+
+import "net/http"
+
+// Doer sends a single HTTP request and returns its response. *http.Client
+// satisfies Doer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer used to send each attempt of a request, returning
+// a Doer that may inspect or modify the request, record the response, or
+// answer the request itself without calling next.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adds middleware around every HTTP attempt made by Client.Do,
+// including retries, so it can add headers, record metrics, propagate tracing
+// context or stub out responses in tests. Middleware sits inside the retry
+// loop: a response it returns is subject to the same rate limit and retry
+// handling as one from the API.
+//
+// Middleware is applied in the order given, with the first outermost, and
+// WithMiddleware may be passed more than once to add to the chain.
+func WithMiddleware(mw ...Middleware) ClientOpt {
+	return func(c *Client) error {
+		c.middleware = append(c.middleware, mw...)
+		return nil
+	}
+}
+
+// doer returns the client's HTTP client wrapped in its dry-run recorder and
+// response cache, if any, then its middleware, and then its token source, if
+// any, so that the middleware and cache see the Authorization header.
+func (c *Client) doer() Doer {
+	var d Doer = c.client
+	if c.dryRun != nil {
+		d = dryRunDoer{next: d, sink: c.dryRun}
+	}
+	if c.cache != nil {
+		d = cachingDoer{next: d, store: c.cache}
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		d = c.middleware[i](d)
+	}
+	if c.tokenSource != nil {
+		d = tokenDoer{next: d, source: c.tokenSource}
+	}
+	return d
+}
@@ -0,0 +1,143 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithMiddleware_Order(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff(r.Header.Values("X-Trace"), []string{"outer", "inner"}); diff != "" {
+			t.Errorf("X-Trace header diff: (-got +want)\n%s", diff)
+		}
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				req.Header.Add("X-Trace", name)
+				resp, err := next.Do(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			})
+		}
+	}
+
+	if err := WithMiddleware(trace("outer"), trace("inner"))(client); err != nil {
+		t.Fatalf("WithMiddleware: %v", err)
+	}
+
+	if _, _, err := client.Pipelines.List(context.Background(), "my-great-org", nil); err != nil {
+		t.Fatalf("Pipelines.List returned error: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if diff := cmp.Diff(calls, want); diff != "" {
+		t.Errorf("middleware calls diff: (-got +want)\n%s", diff)
+	}
+}
+
+// TestWithMiddleware_SeesEachAttempt verifies middleware runs inside the retry
+// loop, once per attempt.
+func TestWithMiddleware_SeesEachAttempt(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	client.sleepFunc = func(time.Duration) {}
+
+	callCount := 0
+	server.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if callCount == 1 {
+			w.Header().Set("RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var statuses []int
+	if err := WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			if err == nil {
+				statuses = append(statuses, resp.StatusCode)
+			}
+			return resp, err
+		})
+	})(client); err != nil {
+		t.Fatalf("WithMiddleware: %v", err)
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(statuses, []int{http.StatusTooManyRequests, http.StatusOK}); diff != "" {
+		t.Errorf("statuses seen by middleware diff: (-got +want)\n%s", diff)
+	}
+}
+
+// TestWithMiddleware_ShortCircuit verifies middleware can answer a request
+// without it reaching the server, including with an error status.
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	// No handlers are registered, so any request reaching the server fails the test.
+	_, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	if err := WithMiddleware(func(Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/missing") {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader(`{"message":"No pipeline found"}`)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"slug":"stubbed"}`)),
+			}, nil
+		})
+	})(client); err != nil {
+		t.Fatalf("WithMiddleware: %v", err)
+	}
+
+	pipeline, _, err := client.Pipelines.Get(context.Background(), "my-great-org", "stubbed")
+	if err != nil {
+		t.Fatalf("Pipelines.Get returned error: %v", err)
+	}
+	if pipeline.Slug != "stubbed" {
+		t.Errorf("Pipelines.Get slug = %q, want %q", pipeline.Slug, "stubbed")
+	}
+
+	_, _, err = client.Pipelines.Get(context.Background(), "my-great-org", "missing")
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("expected *ErrorResponse, got %T: %v", err, err)
+	}
+	if errResp.Message != "No pipeline found" || !strings.Contains(errResp.Error(), "GET") {
+		t.Errorf("unexpected error %v", errResp)
+	}
+}
@@ -0,0 +1,229 @@
+// Package oauth implements the OAuth 2.0 authorization code flow for
+// Buildkite, so an application can act on behalf of individual Buildkite
+// users rather than with one shared API access token.
+//
+// Send the user to the URL from AuthCodeURL with a fresh state and PKCE
+// verifier, kept in their session; when Buildkite redirects back, check the
+// state and exchange the code for a token:
+//
+//	cfg := &oauth.Config{
+//		ClientID:     os.Getenv("BUILDKITE_OAUTH_CLIENT_ID"),
+//		ClientSecret: os.Getenv("BUILDKITE_OAUTH_CLIENT_SECRET"),
+//		RedirectURL:  "https://portal.example.com/oauth/callback",
+//		Scopes:       []string{"read_user", "read_builds"},
+//	}
+//
+//	// In the login handler:
+//	state, err := oauth.GenerateState()
+//	pkce, err := oauth.NewPKCE()
+//	http.Redirect(w, r, cfg.AuthCodeURL(state, pkce), http.StatusFound)
+//
+//	// In the callback handler, with state and pkce loaded from the session:
+//	if err := oauth.CheckState(state, r.FormValue("state")); err != nil {
+//		...
+//	}
+//	token, err := cfg.Exchange(ctx, r.FormValue("code"), pkce)
+//	client, err := buildkite.NewOpts(buildkite.WithOAuthToken(token))
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/buildkite/go-buildkite/v5"
+)
+
+const (
+	// DefaultAuthURL is Buildkite's OAuth authorization endpoint.
+	DefaultAuthURL = "https://buildkite.com/oauth/authorize"
+
+	// DefaultTokenURL is Buildkite's OAuth token endpoint.
+	DefaultTokenURL = "https://buildkite.com/oauth/token"
+)
+
+// ErrStateMismatch is returned by CheckState when the state returned to the
+// redirect URL is not the one the flow was started with.
+var ErrStateMismatch = errors.New("oauth: state mismatch")
+
+// Config describes an OAuth application registered with Buildkite.
+type Config struct {
+	// ClientID and ClientSecret are the application's credentials.
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the URL Buildkite redirects the user back to with the
+	// authorization code. It must match one registered for the application.
+	RedirectURL string
+
+	// Scopes are the API access token scopes to request, such as
+	// "read_builds".
+	Scopes []string
+
+	// AuthURL and TokenURL override DefaultAuthURL and DefaultTokenURL.
+	AuthURL  string
+	TokenURL string
+
+	// HTTPClient is used to exchange codes for tokens. It defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Error is an error response from the token endpoint.
+type Error struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("oauth: %s (%d)", e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("oauth: %s: %s (%d)", e.Code, e.Description, e.StatusCode)
+}
+
+// PKCE is a Proof Key for Code Exchange verifier and the challenge derived
+// from it (RFC 7636). The challenge is sent with the authorization request,
+// and the verifier, which must be kept secret, with the code exchange.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+	Method    string
+}
+
+// NewPKCE returns a random verifier with its S256 challenge.
+func NewPKCE() (*PKCE, error) {
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return &PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+		Method:    "S256",
+	}, nil
+}
+
+// GenerateState returns a random value for the state parameter, which ties
+// the redirect back from Buildkite to the session that started the flow.
+func GenerateState() (string, error) {
+	return randomString(32)
+}
+
+// CheckState returns ErrStateMismatch unless got, the state returned to the
+// redirect URL, equals want, the state the flow was started with.
+func CheckState(want, got string) error {
+	if want == "" || subtle.ConstantTimeCompare([]byte(want), []byte(got)) != 1 {
+		return ErrStateMismatch
+	}
+	return nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("oauth: generating random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthCodeURL returns the URL to send the user to in order to authorize the
+// application. pkce may be nil to skip PKCE, though it is recommended.
+func (c *Config) AuthCodeURL(state string, pkce *PKCE) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.ClientID},
+		"state":         {state},
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	if pkce != nil {
+		v.Set("code_challenge", pkce.Challenge)
+		v.Set("code_challenge_method", pkce.Method)
+	}
+
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = DefaultAuthURL
+	}
+	if strings.Contains(authURL, "?") {
+		return authURL + "&" + v.Encode()
+	}
+	return authURL + "?" + v.Encode()
+}
+
+// Exchange exchanges an authorization code for an access token. pkce must be
+// the one passed to AuthCodeURL, or nil if none was. An error response from
+// the token endpoint is returned as an *Error.
+func (c *Config) Exchange(ctx context.Context, code string, pkce *PKCE) (buildkite.Token, error) {
+	v := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if pkce != nil {
+		v.Set("code_verifier", pkce.Verifier)
+	}
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = DefaultTokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return buildkite.Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return buildkite.Token{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return buildkite.Token{}, fmt.Errorf("oauth: reading token response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		oauthErr := &Error{StatusCode: resp.StatusCode}
+		if json.Unmarshal(body, oauthErr) != nil || oauthErr.Code == "" {
+			oauthErr.Code = http.StatusText(resp.StatusCode)
+		}
+		return buildkite.Token{}, oauthErr
+	}
+
+	var token buildkite.Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return buildkite.Token{}, fmt.Errorf("oauth: decoding token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return buildkite.Token{}, errors.New("oauth: token response has no access_token")
+	}
+	return token, nil
+}
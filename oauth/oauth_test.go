@@ -0,0 +1,186 @@
+package oauth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/buildkite/go-buildkite/v5"
+	"github.com/buildkite/go-buildkite/v5/oauth"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	cfg := &oauth.Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://portal.example.com/callback",
+		Scopes:      []string{"read_user", "read_builds"},
+	}
+	pkce, err := oauth.NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE: %v", err)
+	}
+
+	u, err := url.Parse(cfg.AuthCodeURL("state-123", pkce))
+	if err != nil {
+		t.Fatalf("parsing AuthCodeURL: %v", err)
+	}
+	if got, want := u.Scheme+"://"+u.Host+u.Path, oauth.DefaultAuthURL; got != want {
+		t.Errorf("AuthCodeURL endpoint = %q, want %q", got, want)
+	}
+
+	want := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"client-id"},
+		"redirect_uri":          {"https://portal.example.com/callback"},
+		"scope":                 {"read_user read_builds"},
+		"state":                 {"state-123"},
+		"code_challenge":        {pkce.Challenge},
+		"code_challenge_method": {"S256"},
+	}
+	if diff := cmp.Diff(want, u.Query()); diff != "" {
+		t.Errorf("AuthCodeURL query diff: (-want +got)\n%s", diff)
+	}
+
+	sum := sha256.Sum256([]byte(pkce.Verifier))
+	if got := base64.RawURLEncoding.EncodeToString(sum[:]); got != pkce.Challenge {
+		t.Errorf("PKCE challenge = %q, want S256 of verifier %q", pkce.Challenge, got)
+	}
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	t.Parallel()
+
+	pkce, err := oauth.NewPKCE()
+	if err != nil {
+		t.Fatalf("NewPKCE: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm: %v", err)
+		}
+
+		want := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"the-code"},
+			"client_id":     {"client-id"},
+			"client_secret": {"client-secret"},
+			"redirect_uri":  {"https://portal.example.com/callback"},
+			"code_verifier": {pkce.Verifier},
+		}
+		if diff := cmp.Diff(want, r.PostForm); diff != "" {
+			t.Errorf("token request diff: (-want +got)\n%s", diff)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"bkua_abc","token_type":"Bearer","scope":"read_user read_builds"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &oauth.Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://portal.example.com/callback",
+		TokenURL:     srv.URL,
+	}
+	token, err := cfg.Exchange(context.Background(), "the-code", pkce)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+
+	want := buildkite.Token{AccessToken: "bkua_abc", Type: "Bearer", Scope: "read_user read_builds"}
+	if diff := cmp.Diff(want, token); diff != "" {
+		t.Errorf("Exchange token diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestConfig_Exchange_Error(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"The code has expired."}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &oauth.Config{ClientID: "client-id", TokenURL: srv.URL}
+	_, err := cfg.Exchange(context.Background(), "stale", nil)
+
+	var oauthErr *oauth.Error
+	if !errors.As(err, &oauthErr) {
+		t.Fatalf("Exchange error = %v, want *oauth.Error", err)
+	}
+	want := &oauth.Error{StatusCode: 400, Code: "invalid_grant", Description: "The code has expired."}
+	if diff := cmp.Diff(want, oauthErr); diff != "" {
+		t.Errorf("Exchange error diff: (-want +got)\n%s", diff)
+	}
+}
+
+func TestCheckState(t *testing.T) {
+	t.Parallel()
+
+	state, err := oauth.GenerateState()
+	if err != nil {
+		t.Fatalf("GenerateState: %v", err)
+	}
+	other, err := oauth.GenerateState()
+	if err != nil {
+		t.Fatalf("GenerateState: %v", err)
+	}
+	if state == other {
+		t.Errorf("GenerateState returned %q twice", state)
+	}
+
+	if err := oauth.CheckState(state, state); err != nil {
+		t.Errorf("CheckState(matching) = %v, want nil", err)
+	}
+	for _, got := range []string{other, ""} {
+		if err := oauth.CheckState(state, got); !errors.Is(err, oauth.ErrStateMismatch) {
+			t.Errorf("CheckState(%q) = %v, want ErrStateMismatch", got, err)
+		}
+	}
+	if err := oauth.CheckState("", ""); !errors.Is(err, oauth.ErrStateMismatch) {
+		t.Errorf("CheckState with no state = %v, want ErrStateMismatch", err)
+	}
+}
+
+func TestWithOAuthToken(t *testing.T) {
+	t.Parallel()
+
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"id":"abc"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := buildkite.NewOpts(
+		buildkite.WithBaseURL(srv.URL),
+		buildkite.WithOAuthToken(buildkite.Token{AccessToken: "bkua_abc", Type: "bearer"}),
+	)
+	if err != nil {
+		t.Fatalf("NewOpts: %v", err)
+	}
+	if _, _, err := client.User.CurrentUser(context.Background()); err != nil {
+		t.Fatalf("CurrentUser: %v", err)
+	}
+	if auth != "Bearer bkua_abc" {
+		t.Errorf("Authorization = %q, want %q", auth, "Bearer bkua_abc")
+	}
+
+	if _, err := buildkite.NewOpts(buildkite.WithOAuthToken(buildkite.Token{AccessToken: "x", Type: "mac"})); err == nil {
+		t.Error("NewOpts with a mac token returned no error")
+	}
+}
@@ -0,0 +1,1137 @@
+package buildkite
+
+import (
+	"context"
+	"iter"
+)
+
+// OrgClient is a view of a Client with its services bound to one
+// organization, so the organization slug needn't be passed to every call:
+//
+//	acme := client.Org("acme")
+//	pipeline, _, err := acme.Pipelines.Get(ctx, "web")
+//	builds, _, err := acme.Builds.ListByPipeline(ctx, "web", nil)
+//
+// Each service has the methods of the Client service of the same name that
+// take an organization, with the same arguments after it. An OrgClient shares
+// its Client's configuration and is cheap to create.
+type OrgClient struct {
+	client *Client
+	slug   string
+
+	Agents                       *OrgAgents
+	Annotations                  *OrgAnnotations
+	Artifacts                    *OrgArtifacts
+	BuildTests                   *OrgBuildTests
+	Builds                       *OrgBuilds
+	ClusterMaintainers           *OrgClusterMaintainers
+	ClusterQueues                *OrgClusterQueues
+	ClusterSecrets               *OrgClusterSecrets
+	ClusterTokens                *OrgClusterTokens
+	Clusters                     *OrgClusters
+	Emojis                       *OrgEmojis
+	FlakyTests                   *OrgFlakyTests
+	Jobs                         *OrgJobs
+	Members                      *OrgMembers
+	PackageRegistriesService     *OrgPackageRegistries
+	PackageRegistryTokensService *OrgPackageRegistryTokens
+	PackagesService              *OrgPackages
+	PipelineSchedules            *OrgPipelineSchedules
+	PipelineTemplates            *OrgPipelineTemplates
+	Pipelines                    *OrgPipelines
+	RateLimit                    *OrgRateLimit
+	Rules                        *OrgRules
+	StepUploads                  *OrgStepUploads
+	TeamMember                   *OrgTeamMember
+	TeamPipelines                *OrgTeamPipelines
+	TeamSuites                   *OrgTeamSuites
+	Teams                        *OrgTeams
+	TestRuns                     *OrgTestRuns
+	TestSuites                   *OrgTestSuites
+	Tests                        *OrgTests
+}
+
+// Org returns a view of the client bound to the organization with the given
+// slug.
+func (c *Client) Org(slug string) *OrgClient {
+	return &OrgClient{
+		client: c,
+		slug:   slug,
+
+		Agents:                       &OrgAgents{s: c.Agents, org: slug},
+		Annotations:                  &OrgAnnotations{s: c.Annotations, org: slug},
+		Artifacts:                    &OrgArtifacts{s: c.Artifacts, org: slug},
+		BuildTests:                   &OrgBuildTests{s: c.BuildTests, org: slug},
+		Builds:                       &OrgBuilds{s: c.Builds, org: slug},
+		ClusterMaintainers:           &OrgClusterMaintainers{s: c.ClusterMaintainers, org: slug},
+		ClusterQueues:                &OrgClusterQueues{s: c.ClusterQueues, org: slug},
+		ClusterSecrets:               &OrgClusterSecrets{s: c.ClusterSecrets, org: slug},
+		ClusterTokens:                &OrgClusterTokens{s: c.ClusterTokens, org: slug},
+		Clusters:                     &OrgClusters{s: c.Clusters, org: slug},
+		Emojis:                       &OrgEmojis{s: c.Emojis, org: slug},
+		FlakyTests:                   &OrgFlakyTests{s: c.FlakyTests, org: slug},
+		Jobs:                         &OrgJobs{s: c.Jobs, org: slug},
+		Members:                      &OrgMembers{s: c.Members, org: slug},
+		PackageRegistriesService:     &OrgPackageRegistries{s: c.PackageRegistriesService, org: slug},
+		PackageRegistryTokensService: &OrgPackageRegistryTokens{s: c.PackageRegistryTokensService, org: slug},
+		PackagesService:              &OrgPackages{s: c.PackagesService, org: slug},
+		PipelineSchedules:            &OrgPipelineSchedules{s: c.PipelineSchedules, org: slug},
+		PipelineTemplates:            &OrgPipelineTemplates{s: c.PipelineTemplates, org: slug},
+		Pipelines:                    &OrgPipelines{s: c.Pipelines, org: slug},
+		RateLimit:                    &OrgRateLimit{s: c.RateLimit, org: slug},
+		Rules:                        &OrgRules{s: c.Rules, org: slug},
+		StepUploads:                  &OrgStepUploads{s: c.StepUploads, org: slug},
+		TeamMember:                   &OrgTeamMember{s: c.TeamMember, org: slug},
+		TeamPipelines:                &OrgTeamPipelines{s: c.TeamPipelines, org: slug},
+		TeamSuites:                   &OrgTeamSuites{s: c.TeamSuites, org: slug},
+		Teams:                        &OrgTeams{s: c.Teams, org: slug},
+		TestRuns:                     &OrgTestRuns{s: c.TestRuns, org: slug},
+		TestSuites:                   &OrgTestSuites{s: c.TestSuites, org: slug},
+		Tests:                        &OrgTests{s: c.Tests, org: slug},
+	}
+}
+
+// Slug returns the organization's slug.
+func (o *OrgClient) Slug() string {
+	return o.slug
+}
+
+// Get calls OrganizationsService.Get for the organization.
+func (o *OrgClient) Get(ctx context.Context) (Organization, *Response, error) {
+	return o.client.Organizations.Get(ctx, o.slug)
+}
+
+// OrgAgents is AgentsService bound to an organization.
+type OrgAgents struct {
+	s   *AgentsService
+	org string
+}
+
+// List calls AgentsService.List for the organization.
+func (o *OrgAgents) List(ctx context.Context, opt *AgentListOptions) ([]Agent, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls AgentsService.ListAll for the organization.
+func (o *OrgAgents) ListAll(ctx context.Context, opt *AgentListOptions) iter.Seq2[Agent, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls AgentsService.Get for the organization.
+func (o *OrgAgents) Get(ctx context.Context, id string) (Agent, *Response, error) {
+	return o.s.Get(ctx, o.org, id)
+}
+
+// Create calls AgentsService.Create for the organization.
+func (o *OrgAgents) Create(ctx context.Context, agent Agent) (Agent, *Response, error) {
+	return o.s.Create(ctx, o.org, agent)
+}
+
+// Delete calls AgentsService.Delete for the organization.
+func (o *OrgAgents) Delete(ctx context.Context, id string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, id)
+}
+
+// Stop calls AgentsService.Stop for the organization.
+func (o *OrgAgents) Stop(ctx context.Context, id string, force bool) (*Response, error) {
+	return o.s.Stop(ctx, o.org, id, force)
+}
+
+// Pause calls AgentsService.Pause for the organization.
+func (o *OrgAgents) Pause(ctx context.Context, id string, opts *AgentPauseOptions) (*Response, error) {
+	return o.s.Pause(ctx, o.org, id, opts)
+}
+
+// Resume calls AgentsService.Resume for the organization.
+func (o *OrgAgents) Resume(ctx context.Context, id string) (*Response, error) {
+	return o.s.Resume(ctx, o.org, id)
+}
+
+// OrgAnnotations is AnnotationsService bound to an organization.
+type OrgAnnotations struct {
+	s   *AnnotationsService
+	org string
+}
+
+// ListByBuild calls AnnotationsService.ListByBuild for the organization.
+func (o *OrgAnnotations) ListByBuild(ctx context.Context, pipeline, build string, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+	return o.s.ListByBuild(ctx, o.org, pipeline, build, opt)
+}
+
+// ListByBuildAll calls AnnotationsService.ListByBuildAll for the organization.
+func (o *OrgAnnotations) ListByBuildAll(ctx context.Context, pipeline, build string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return o.s.ListByBuildAll(ctx, o.org, pipeline, build, opt)
+}
+
+// Create calls AnnotationsService.Create for the organization.
+func (o *OrgAnnotations) Create(ctx context.Context, pipeline, build string, ac AnnotationCreate) (Annotation, *Response, error) {
+	return o.s.Create(ctx, o.org, pipeline, build, ac)
+}
+
+// Delete calls AnnotationsService.Delete for the organization.
+func (o *OrgAnnotations) Delete(ctx context.Context, pipeline, build, annotationUUID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, pipeline, build, annotationUUID)
+}
+
+// ListByJob calls AnnotationsService.ListByJob for the organization.
+func (o *OrgAnnotations) ListByJob(ctx context.Context, pipeline, build, jobID string, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+	return o.s.ListByJob(ctx, o.org, pipeline, build, jobID, opt)
+}
+
+// ListByJobAll calls AnnotationsService.ListByJobAll for the organization.
+func (o *OrgAnnotations) ListByJobAll(ctx context.Context, pipeline, build, jobID string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return o.s.ListByJobAll(ctx, o.org, pipeline, build, jobID, opt)
+}
+
+// CreateForJob calls AnnotationsService.CreateForJob for the organization.
+func (o *OrgAnnotations) CreateForJob(ctx context.Context, pipeline, build, jobID string, ac AnnotationCreate) (Annotation, *Response, error) {
+	return o.s.CreateForJob(ctx, o.org, pipeline, build, jobID, ac)
+}
+
+// DeleteForJob calls AnnotationsService.DeleteForJob for the organization.
+func (o *OrgAnnotations) DeleteForJob(ctx context.Context, pipeline, build, jobID, annotationUUID string) (*Response, error) {
+	return o.s.DeleteForJob(ctx, o.org, pipeline, build, jobID, annotationUUID)
+}
+
+// OrgArtifacts is ArtifactsService bound to an organization.
+type OrgArtifacts struct {
+	s   *ArtifactsService
+	org string
+}
+
+// ListByBuild calls ArtifactsService.ListByBuild for the organization.
+func (o *OrgArtifacts) ListByBuild(ctx context.Context, pipeline, build string, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+	return o.s.ListByBuild(ctx, o.org, pipeline, build, opt)
+}
+
+// ListByBuildAll calls ArtifactsService.ListByBuildAll for the organization.
+func (o *OrgArtifacts) ListByBuildAll(ctx context.Context, pipeline, build string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return o.s.ListByBuildAll(ctx, o.org, pipeline, build, opt)
+}
+
+// ListByJob calls ArtifactsService.ListByJob for the organization.
+func (o *OrgArtifacts) ListByJob(ctx context.Context, pipeline, build, job string, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+	return o.s.ListByJob(ctx, o.org, pipeline, build, job, opt)
+}
+
+// ListByJobAll calls ArtifactsService.ListByJobAll for the organization.
+func (o *OrgArtifacts) ListByJobAll(ctx context.Context, pipeline, build, job string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return o.s.ListByJobAll(ctx, o.org, pipeline, build, job, opt)
+}
+
+// Get calls ArtifactsService.Get for the organization.
+func (o *OrgArtifacts) Get(ctx context.Context, pipeline, build, job, id string) (Artifact, *Response, error) {
+	return o.s.Get(ctx, o.org, pipeline, build, job, id)
+}
+
+// Delete calls ArtifactsService.Delete for the organization.
+func (o *OrgArtifacts) Delete(ctx context.Context, pipeline, build, job, id string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, pipeline, build, job, id)
+}
+
+// OrgBuildTests is BuildTestsService bound to an organization.
+type OrgBuildTests struct {
+	s   *BuildTestsService
+	org string
+}
+
+// List calls BuildTestsService.List for the organization.
+func (o *OrgBuildTests) List(ctx context.Context, buildUUID string, opt *BuildTestsListOptions) ([]TestWithMetrics, *Response, error) {
+	return o.s.List(ctx, o.org, buildUUID, opt)
+}
+
+// ListAll calls BuildTestsService.ListAll for the organization.
+func (o *OrgBuildTests) ListAll(ctx context.Context, buildUUID string, opt *BuildTestsListOptions) iter.Seq2[TestWithMetrics, error] {
+	return o.s.ListAll(ctx, o.org, buildUUID, opt)
+}
+
+// OrgBuilds is BuildsService bound to an organization.
+type OrgBuilds struct {
+	s   *BuildsService
+	org string
+}
+
+// BulkCancel calls BuildsService.BulkCancel for the organization.
+func (o *OrgBuilds) BulkCancel(ctx context.Context, pipeline string, opt *BulkOptions) (BulkReport, error) {
+	return o.s.BulkCancel(ctx, o.org, pipeline, opt)
+}
+
+// BulkRebuild calls BuildsService.BulkRebuild for the organization.
+func (o *OrgBuilds) BulkRebuild(ctx context.Context, pipeline string, opt *BulkOptions) (BulkReport, error) {
+	return o.s.BulkRebuild(ctx, o.org, pipeline, opt)
+}
+
+// Cancel calls BuildsService.Cancel for the organization.
+func (o *OrgBuilds) Cancel(ctx context.Context, pipeline, buildNumber string) (Build, error) {
+	return o.s.Cancel(ctx, o.org, pipeline, buildNumber)
+}
+
+// Create calls BuildsService.Create for the organization.
+func (o *OrgBuilds) Create(ctx context.Context, pipeline string, b CreateBuild) (Build, *Response, error) {
+	return o.s.Create(ctx, o.org, pipeline, b)
+}
+
+// CreateAndWait calls BuildsService.CreateAndWait for the organization.
+func (o *OrgBuilds) CreateAndWait(ctx context.Context, pipeline string, b CreateBuild, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	return o.s.CreateAndWait(ctx, o.org, pipeline, b, opt)
+}
+
+// Get calls BuildsService.Get for the organization.
+func (o *OrgBuilds) Get(ctx context.Context, pipeline, buildNumber string, opt *BuildGetOptions) (Build, *Response, error) {
+	return o.s.Get(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// ListByOrg calls BuildsService.ListByOrg for the organization.
+func (o *OrgBuilds) ListByOrg(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error) {
+	return o.s.ListByOrg(ctx, o.org, opt)
+}
+
+// ListByOrgAll calls BuildsService.ListByOrgAll for the organization.
+func (o *OrgBuilds) ListByOrgAll(ctx context.Context, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return o.s.ListByOrgAll(ctx, o.org, opt)
+}
+
+// ListByPipeline calls BuildsService.ListByPipeline for the organization.
+func (o *OrgBuilds) ListByPipeline(ctx context.Context, pipeline string, opt *BuildsListOptions) ([]Build, *Response, error) {
+	return o.s.ListByPipeline(ctx, o.org, pipeline, opt)
+}
+
+// ListByPipelineAll calls BuildsService.ListByPipelineAll for the organization.
+func (o *OrgBuilds) ListByPipelineAll(ctx context.Context, pipeline string, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return o.s.ListByPipelineAll(ctx, o.org, pipeline, opt)
+}
+
+// Rebuild calls BuildsService.Rebuild for the organization.
+func (o *OrgBuilds) Rebuild(ctx context.Context, pipeline, buildNumber string) (Build, error) {
+	return o.s.Rebuild(ctx, o.org, pipeline, buildNumber)
+}
+
+// Tree calls BuildsService.Tree for the organization.
+func (o *OrgBuilds) Tree(ctx context.Context, pipeline, buildNumber string, opt *BuildTreeOptions) (*BuildTree, error) {
+	return o.s.Tree(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// WaitForBuild calls BuildsService.WaitForBuild for the organization.
+func (o *OrgBuilds) WaitForBuild(ctx context.Context, pipeline, buildNumber string, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	return o.s.WaitForBuild(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// OrgClusterMaintainers is ClusterMaintainersService bound to an organization.
+type OrgClusterMaintainers struct {
+	s   *ClusterMaintainersService
+	org string
+}
+
+// List calls ClusterMaintainersService.List for the organization.
+func (o *OrgClusterMaintainers) List(ctx context.Context, clusterID string, opt *ClusterMaintainersListOptions) ([]ClusterMaintainerEntry, *Response, error) {
+	return o.s.List(ctx, o.org, clusterID, opt)
+}
+
+// ListAll calls ClusterMaintainersService.ListAll for the organization.
+func (o *OrgClusterMaintainers) ListAll(ctx context.Context, clusterID string, opt *ClusterMaintainersListOptions) iter.Seq2[ClusterMaintainerEntry, error] {
+	return o.s.ListAll(ctx, o.org, clusterID, opt)
+}
+
+// Get calls ClusterMaintainersService.Get for the organization.
+func (o *OrgClusterMaintainers) Get(ctx context.Context, clusterID, id string) (ClusterMaintainerEntry, *Response, error) {
+	return o.s.Get(ctx, o.org, clusterID, id)
+}
+
+// Create calls ClusterMaintainersService.Create for the organization.
+func (o *OrgClusterMaintainers) Create(ctx context.Context, clusterID string, input ClusterMaintainer) (ClusterMaintainerEntry, *Response, error) {
+	return o.s.Create(ctx, o.org, clusterID, input)
+}
+
+// Delete calls ClusterMaintainersService.Delete for the organization.
+func (o *OrgClusterMaintainers) Delete(ctx context.Context, clusterID, id string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, clusterID, id)
+}
+
+// OrgClusterQueues is ClusterQueuesService bound to an organization.
+type OrgClusterQueues struct {
+	s   *ClusterQueuesService
+	org string
+}
+
+// List calls ClusterQueuesService.List for the organization.
+func (o *OrgClusterQueues) List(ctx context.Context, clusterID string, opt *ClusterQueuesListOptions) ([]ClusterQueue, *Response, error) {
+	return o.s.List(ctx, o.org, clusterID, opt)
+}
+
+// ListAll calls ClusterQueuesService.ListAll for the organization.
+func (o *OrgClusterQueues) ListAll(ctx context.Context, clusterID string, opt *ClusterQueuesListOptions) iter.Seq2[ClusterQueue, error] {
+	return o.s.ListAll(ctx, o.org, clusterID, opt)
+}
+
+// Get calls ClusterQueuesService.Get for the organization.
+func (o *OrgClusterQueues) Get(ctx context.Context, clusterID, queueID string) (ClusterQueue, *Response, error) {
+	return o.s.Get(ctx, o.org, clusterID, queueID)
+}
+
+// Create calls ClusterQueuesService.Create for the organization.
+func (o *OrgClusterQueues) Create(ctx context.Context, clusterID string, qc ClusterQueueCreate) (ClusterQueue, *Response, error) {
+	return o.s.Create(ctx, o.org, clusterID, qc)
+}
+
+// Update calls ClusterQueuesService.Update for the organization.
+func (o *OrgClusterQueues) Update(ctx context.Context, clusterID, queueID string, qu ClusterQueueUpdate) (ClusterQueue, *Response, error) {
+	return o.s.Update(ctx, o.org, clusterID, queueID, qu)
+}
+
+// Delete calls ClusterQueuesService.Delete for the organization.
+func (o *OrgClusterQueues) Delete(ctx context.Context, clusterID, queueID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, clusterID, queueID)
+}
+
+// Pause calls ClusterQueuesService.Pause for the organization.
+func (o *OrgClusterQueues) Pause(ctx context.Context, clusterID, queueID string, qp ClusterQueuePause) (ClusterQueue, *Response, error) {
+	return o.s.Pause(ctx, o.org, clusterID, queueID, qp)
+}
+
+// Resume calls ClusterQueuesService.Resume for the organization.
+func (o *OrgClusterQueues) Resume(ctx context.Context, clusterID, queueID string) (*Response, error) {
+	return o.s.Resume(ctx, o.org, clusterID, queueID)
+}
+
+// OrgClusterSecrets is ClusterSecretsService bound to an organization.
+type OrgClusterSecrets struct {
+	s   *ClusterSecretsService
+	org string
+}
+
+// List calls ClusterSecretsService.List for the organization.
+func (o *OrgClusterSecrets) List(ctx context.Context, clusterID string, opt *ClusterSecretsListOptions) ([]ClusterSecret, *Response, error) {
+	return o.s.List(ctx, o.org, clusterID, opt)
+}
+
+// ListAll calls ClusterSecretsService.ListAll for the organization.
+func (o *OrgClusterSecrets) ListAll(ctx context.Context, clusterID string, opt *ClusterSecretsListOptions) iter.Seq2[ClusterSecret, error] {
+	return o.s.ListAll(ctx, o.org, clusterID, opt)
+}
+
+// Get calls ClusterSecretsService.Get for the organization.
+func (o *OrgClusterSecrets) Get(ctx context.Context, clusterID, secretID string) (ClusterSecret, *Response, error) {
+	return o.s.Get(ctx, o.org, clusterID, secretID)
+}
+
+// Create calls ClusterSecretsService.Create for the organization.
+func (o *OrgClusterSecrets) Create(ctx context.Context, clusterID string, input ClusterSecretCreate) (ClusterSecret, *Response, error) {
+	return o.s.Create(ctx, o.org, clusterID, input)
+}
+
+// Update calls ClusterSecretsService.Update for the organization.
+func (o *OrgClusterSecrets) Update(ctx context.Context, clusterID, secretID string, input ClusterSecretUpdate) (ClusterSecret, *Response, error) {
+	return o.s.Update(ctx, o.org, clusterID, secretID, input)
+}
+
+// UpdateValue calls ClusterSecretsService.UpdateValue for the organization.
+func (o *OrgClusterSecrets) UpdateValue(ctx context.Context, clusterID, secretID string, input ClusterSecretValueUpdate) (*Response, error) {
+	return o.s.UpdateValue(ctx, o.org, clusterID, secretID, input)
+}
+
+// Delete calls ClusterSecretsService.Delete for the organization.
+func (o *OrgClusterSecrets) Delete(ctx context.Context, clusterID, secretID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, clusterID, secretID)
+}
+
+// OrgClusterTokens is ClusterTokensService bound to an organization.
+type OrgClusterTokens struct {
+	s   *ClusterTokensService
+	org string
+}
+
+// List calls ClusterTokensService.List for the organization.
+func (o *OrgClusterTokens) List(ctx context.Context, clusterID string, opt *ClusterTokensListOptions) ([]ClusterToken, *Response, error) {
+	return o.s.List(ctx, o.org, clusterID, opt)
+}
+
+// ListAll calls ClusterTokensService.ListAll for the organization.
+func (o *OrgClusterTokens) ListAll(ctx context.Context, clusterID string, opt *ClusterTokensListOptions) iter.Seq2[ClusterToken, error] {
+	return o.s.ListAll(ctx, o.org, clusterID, opt)
+}
+
+// Get calls ClusterTokensService.Get for the organization.
+func (o *OrgClusterTokens) Get(ctx context.Context, clusterID, tokenID string) (ClusterToken, *Response, error) {
+	return o.s.Get(ctx, o.org, clusterID, tokenID)
+}
+
+// Create calls ClusterTokensService.Create for the organization.
+func (o *OrgClusterTokens) Create(ctx context.Context, clusterID string, ctc ClusterTokenCreate) (ClusterToken, *Response, error) {
+	return o.s.Create(ctx, o.org, clusterID, ctc)
+}
+
+// Update calls ClusterTokensService.Update for the organization.
+func (o *OrgClusterTokens) Update(ctx context.Context, clusterID, tokenID string, ctc ClusterTokenUpdate) (ClusterToken, *Response, error) {
+	return o.s.Update(ctx, o.org, clusterID, tokenID, ctc)
+}
+
+// Delete calls ClusterTokensService.Delete for the organization.
+func (o *OrgClusterTokens) Delete(ctx context.Context, clusterID, tokenID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, clusterID, tokenID)
+}
+
+// OrgClusters is ClustersService bound to an organization.
+type OrgClusters struct {
+	s   *ClustersService
+	org string
+}
+
+// List calls ClustersService.List for the organization.
+func (o *OrgClusters) List(ctx context.Context, opt *ClustersListOptions) ([]Cluster, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls ClustersService.ListAll for the organization.
+func (o *OrgClusters) ListAll(ctx context.Context, opt *ClustersListOptions) iter.Seq2[Cluster, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls ClustersService.Get for the organization.
+func (o *OrgClusters) Get(ctx context.Context, id string) (Cluster, *Response, error) {
+	return o.s.Get(ctx, o.org, id)
+}
+
+// Create calls ClustersService.Create for the organization.
+func (o *OrgClusters) Create(ctx context.Context, cc ClusterCreate) (Cluster, *Response, error) {
+	return o.s.Create(ctx, o.org, cc)
+}
+
+// Update calls ClustersService.Update for the organization.
+func (o *OrgClusters) Update(ctx context.Context, id string, cu ClusterUpdate) (Cluster, *Response, error) {
+	return o.s.Update(ctx, o.org, id, cu)
+}
+
+// Delete calls ClustersService.Delete for the organization.
+func (o *OrgClusters) Delete(ctx context.Context, id string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, id)
+}
+
+// OrgEmojis is EmojisService bound to an organization.
+type OrgEmojis struct {
+	s   *EmojisService
+	org string
+}
+
+// List calls EmojisService.List for the organization.
+func (o *OrgEmojis) List(ctx context.Context) ([]Emoji, *Response, error) {
+	return o.s.List(ctx, o.org)
+}
+
+// OrgFlakyTests is FlakyTestsService bound to an organization.
+type OrgFlakyTests struct {
+	s   *FlakyTestsService
+	org string
+}
+
+// List calls FlakyTestsService.List for the organization.
+func (o *OrgFlakyTests) List(ctx context.Context, slug string, opt *FlakyTestsListOptions) ([]FlakyTest, *Response, error) {
+	return o.s.List(ctx, o.org, slug, opt)
+}
+
+// ListAll calls FlakyTestsService.ListAll for the organization.
+func (o *OrgFlakyTests) ListAll(ctx context.Context, slug string, opt *FlakyTestsListOptions) iter.Seq2[FlakyTest, error] {
+	return o.s.ListAll(ctx, o.org, slug, opt)
+}
+
+// OrgJobs is JobsService bound to an organization.
+type OrgJobs struct {
+	s   *JobsService
+	org string
+}
+
+// ListByBuild calls JobsService.ListByBuild for the organization.
+func (o *OrgJobs) ListByBuild(ctx context.Context, pipeline, buildNumber string, opt *JobsListOptions) (JobsList, *Response, error) {
+	return o.s.ListByBuild(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls JobsService.ListByBuildAll for the organization.
+func (o *OrgJobs) ListByBuildAll(ctx context.Context, pipeline, buildNumber string, opt *JobsListOptions) iter.Seq2[Job, error] {
+	return o.s.ListByBuildAll(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// GetJob calls JobsService.GetJob for the organization.
+func (o *OrgJobs) GetJob(ctx context.Context, pipeline, buildNumber, jobID string) (Job, *Response, error) {
+	return o.s.GetJob(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// GetJobByOrg calls JobsService.GetJobByOrg for the organization.
+func (o *OrgJobs) GetJobByOrg(ctx context.Context, jobID string) (Job, *Response, error) {
+	return o.s.GetJobByOrg(ctx, o.org, jobID)
+}
+
+// UnblockJob calls JobsService.UnblockJob for the organization.
+func (o *OrgJobs) UnblockJob(ctx context.Context, pipeline, buildNumber, jobID string, opt *JobUnblockOptions) (Job, *Response, error) {
+	return o.s.UnblockJob(ctx, o.org, pipeline, buildNumber, jobID, opt)
+}
+
+// RetryJob calls JobsService.RetryJob for the organization.
+func (o *OrgJobs) RetryJob(ctx context.Context, pipeline, buildNumber, jobID string) (Job, *Response, error) {
+	return o.s.RetryJob(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// GetJobLog calls JobsService.GetJobLog for the organization.
+func (o *OrgJobs) GetJobLog(ctx context.Context, pipeline, buildNumber, jobID string) (JobLog, *Response, error) {
+	return o.s.GetJobLog(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// JobLogExists calls JobsService.JobLogExists for the organization.
+func (o *OrgJobs) JobLogExists(ctx context.Context, pipeline, buildNumber, jobID string) (bool, *Response, error) {
+	return o.s.JobLogExists(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// GetJobEnvironmentVariables calls JobsService.GetJobEnvironmentVariables for the organization.
+func (o *OrgJobs) GetJobEnvironmentVariables(ctx context.Context, pipeline, buildNumber, jobID string) (JobEnvs, *Response, error) {
+	return o.s.GetJobEnvironmentVariables(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// ReprioritizeJob calls JobsService.ReprioritizeJob for the organization.
+func (o *OrgJobs) ReprioritizeJob(ctx context.Context, pipeline, buildNumber, jobID string, opt *JobReprioritizationOptions) (Job, *Response, error) {
+	return o.s.ReprioritizeJob(ctx, o.org, pipeline, buildNumber, jobID, opt)
+}
+
+// DeleteJobLog calls JobsService.DeleteJobLog for the organization.
+func (o *OrgJobs) DeleteJobLog(ctx context.Context, pipeline, buildNumber, jobID string) (*Response, error) {
+	return o.s.DeleteJobLog(ctx, o.org, pipeline, buildNumber, jobID)
+}
+
+// OrgMembers is MembersService bound to an organization.
+type OrgMembers struct {
+	s   *MembersService
+	org string
+}
+
+// List calls MembersService.List for the organization.
+func (o *OrgMembers) List(ctx context.Context, opt *MemberListOptions) ([]Member, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls MembersService.ListAll for the organization.
+func (o *OrgMembers) ListAll(ctx context.Context, opt *MemberListOptions) iter.Seq2[Member, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls MembersService.Get for the organization.
+func (o *OrgMembers) Get(ctx context.Context, memberUUID string) (Member, *Response, error) {
+	return o.s.Get(ctx, o.org, memberUUID)
+}
+
+// OrgPackageRegistries is PackageRegistriesService bound to an organization.
+type OrgPackageRegistries struct {
+	s   *PackageRegistriesService
+	org string
+}
+
+// Create calls PackageRegistriesService.Create for the organization.
+func (o *OrgPackageRegistries) Create(ctx context.Context, cpri CreatePackageRegistryInput) (PackageRegistry, *Response, error) {
+	return o.s.Create(ctx, o.org, cpri)
+}
+
+// Update calls PackageRegistriesService.Update for the organization.
+func (o *OrgPackageRegistries) Update(ctx context.Context, registrySlug string, upri UpdatePackageRegistryInput) (PackageRegistry, *Response, error) {
+	return o.s.Update(ctx, o.org, registrySlug, upri)
+}
+
+// Get calls PackageRegistriesService.Get for the organization.
+func (o *OrgPackageRegistries) Get(ctx context.Context, registrySlug string) (PackageRegistry, *Response, error) {
+	return o.s.Get(ctx, o.org, registrySlug)
+}
+
+// List calls PackageRegistriesService.List for the organization.
+func (o *OrgPackageRegistries) List(ctx context.Context) ([]PackageRegistry, *Response, error) {
+	return o.s.List(ctx, o.org)
+}
+
+// ListPackages calls PackageRegistriesService.ListPackages for the organization.
+func (o *OrgPackageRegistries) ListPackages(ctx context.Context, registrySlug string, opts *RegistryPackagesOptions) (RegistryPackages, *Response, error) {
+	return o.s.ListPackages(ctx, o.org, registrySlug, opts)
+}
+
+// ListPackagesAll calls PackageRegistriesService.ListPackagesAll for the organization.
+func (o *OrgPackageRegistries) ListPackagesAll(ctx context.Context, registrySlug string, opts *RegistryPackagesOptions) iter.Seq2[Package, error] {
+	return o.s.ListPackagesAll(ctx, o.org, registrySlug, opts)
+}
+
+// Delete calls PackageRegistriesService.Delete for the organization.
+func (o *OrgPackageRegistries) Delete(ctx context.Context, registrySlug string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, registrySlug)
+}
+
+// OrgPackageRegistryTokens is PackageRegistryTokensService bound to an organization.
+type OrgPackageRegistryTokens struct {
+	s   *PackageRegistryTokensService
+	org string
+}
+
+// Get calls PackageRegistryTokensService.Get for the organization.
+func (o *OrgPackageRegistryTokens) Get(ctx context.Context, registrySlug, tokenID string) (PackageRegistryToken, *Response, error) {
+	return o.s.Get(ctx, o.org, registrySlug, tokenID)
+}
+
+// List calls PackageRegistryTokensService.List for the organization.
+func (o *OrgPackageRegistryTokens) List(ctx context.Context, registrySlug string) ([]PackageRegistryToken, *Response, error) {
+	return o.s.List(ctx, o.org, registrySlug)
+}
+
+// Create calls PackageRegistryTokensService.Create for the organization.
+func (o *OrgPackageRegistryTokens) Create(ctx context.Context, registrySlug string, input CreatePackageRegistryTokenInput) (PackageRegistryToken, *Response, error) {
+	return o.s.Create(ctx, o.org, registrySlug, input)
+}
+
+// Update calls PackageRegistryTokensService.Update for the organization.
+func (o *OrgPackageRegistryTokens) Update(ctx context.Context, registrySlug, tokenID string, input UpdatePackageRegistryTokenInput) (PackageRegistryToken, *Response, error) {
+	return o.s.Update(ctx, o.org, registrySlug, tokenID, input)
+}
+
+// Delete calls PackageRegistryTokensService.Delete for the organization.
+func (o *OrgPackageRegistryTokens) Delete(ctx context.Context, registrySlug, tokenID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, registrySlug, tokenID)
+}
+
+// OrgPackages is PackagesService bound to an organization.
+type OrgPackages struct {
+	s   *PackagesService
+	org string
+}
+
+// Create calls PackagesService.Create for the organization.
+func (o *OrgPackages) Create(ctx context.Context, registrySlug string, cpi CreatePackageInput) (Package, *Response, error) {
+	return o.s.Create(ctx, o.org, registrySlug, cpi)
+}
+
+// RequestPresignedUpload calls PackagesService.RequestPresignedUpload for the organization.
+func (o *OrgPackages) RequestPresignedUpload(ctx context.Context, registrySlug string) (*PackagePresignedUpload, *Response, error) {
+	return o.s.RequestPresignedUpload(ctx, o.org, registrySlug)
+}
+
+// Get calls PackagesService.Get for the organization.
+func (o *OrgPackages) Get(ctx context.Context, registrySlug, packageID string) (Package, *Response, error) {
+	return o.s.Get(ctx, o.org, registrySlug, packageID)
+}
+
+// Copy calls PackagesService.Copy for the organization.
+func (o *OrgPackages) Copy(ctx context.Context, sourceRegistrySlug, packageID, destinationRegistrySlug string) (Package, *Response, error) {
+	return o.s.Copy(ctx, o.org, sourceRegistrySlug, packageID, destinationRegistrySlug)
+}
+
+// Delete calls PackagesService.Delete for the organization.
+func (o *OrgPackages) Delete(ctx context.Context, registrySlug, packageID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, registrySlug, packageID)
+}
+
+// OrgPipelineSchedules is PipelineSchedulesService bound to an organization.
+type OrgPipelineSchedules struct {
+	s   *PipelineSchedulesService
+	org string
+}
+
+// List calls PipelineSchedulesService.List for the organization.
+func (o *OrgPipelineSchedules) List(ctx context.Context, pipelineSlug string, opt *PipelineScheduleListOptions) ([]PipelineSchedule, *Response, error) {
+	return o.s.List(ctx, o.org, pipelineSlug, opt)
+}
+
+// ListAll calls PipelineSchedulesService.ListAll for the organization.
+func (o *OrgPipelineSchedules) ListAll(ctx context.Context, pipelineSlug string, opt *PipelineScheduleListOptions) iter.Seq2[PipelineSchedule, error] {
+	return o.s.ListAll(ctx, o.org, pipelineSlug, opt)
+}
+
+// Get calls PipelineSchedulesService.Get for the organization.
+func (o *OrgPipelineSchedules) Get(ctx context.Context, pipelineSlug, id string) (PipelineSchedule, *Response, error) {
+	return o.s.Get(ctx, o.org, pipelineSlug, id)
+}
+
+// Create calls PipelineSchedulesService.Create for the organization.
+func (o *OrgPipelineSchedules) Create(ctx context.Context, pipelineSlug string, in CreatePipelineSchedule) (PipelineSchedule, *Response, error) {
+	return o.s.Create(ctx, o.org, pipelineSlug, in)
+}
+
+// Update calls PipelineSchedulesService.Update for the organization.
+func (o *OrgPipelineSchedules) Update(ctx context.Context, pipelineSlug, id string, in UpdatePipelineSchedule) (PipelineSchedule, *Response, error) {
+	return o.s.Update(ctx, o.org, pipelineSlug, id, in)
+}
+
+// Delete calls PipelineSchedulesService.Delete for the organization.
+func (o *OrgPipelineSchedules) Delete(ctx context.Context, pipelineSlug, id string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, pipelineSlug, id)
+}
+
+// OrgPipelineTemplates is PipelineTemplatesService bound to an organization.
+type OrgPipelineTemplates struct {
+	s   *PipelineTemplatesService
+	org string
+}
+
+// List calls PipelineTemplatesService.List for the organization.
+func (o *OrgPipelineTemplates) List(ctx context.Context, opt *PipelineTemplateListOptions) ([]PipelineTemplate, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls PipelineTemplatesService.ListAll for the organization.
+func (o *OrgPipelineTemplates) ListAll(ctx context.Context, opt *PipelineTemplateListOptions) iter.Seq2[PipelineTemplate, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls PipelineTemplatesService.Get for the organization.
+func (o *OrgPipelineTemplates) Get(ctx context.Context, templateUUID string) (PipelineTemplate, *Response, error) {
+	return o.s.Get(ctx, o.org, templateUUID)
+}
+
+// Create calls PipelineTemplatesService.Create for the organization.
+func (o *OrgPipelineTemplates) Create(ctx context.Context, ptc PipelineTemplateCreate) (PipelineTemplate, *Response, error) {
+	return o.s.Create(ctx, o.org, ptc)
+}
+
+// Update calls PipelineTemplatesService.Update for the organization.
+func (o *OrgPipelineTemplates) Update(ctx context.Context, templateUUID string, ptu PipelineTemplateUpdate) (PipelineTemplate, *Response, error) {
+	return o.s.Update(ctx, o.org, templateUUID, ptu)
+}
+
+// Delete calls PipelineTemplatesService.Delete for the organization.
+func (o *OrgPipelineTemplates) Delete(ctx context.Context, templateUUID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, templateUUID)
+}
+
+// OrgPipelines is PipelinesService bound to an organization.
+type OrgPipelines struct {
+	s   *PipelinesService
+	org string
+}
+
+// Create calls PipelinesService.Create for the organization.
+func (o *OrgPipelines) Create(ctx context.Context, p CreatePipeline) (Pipeline, *Response, error) {
+	return o.s.Create(ctx, o.org, p)
+}
+
+// Get calls PipelinesService.Get for the organization.
+func (o *OrgPipelines) Get(ctx context.Context, slug string) (Pipeline, *Response, error) {
+	return o.s.Get(ctx, o.org, slug)
+}
+
+// List calls PipelinesService.List for the organization.
+func (o *OrgPipelines) List(ctx context.Context, opt *PipelineListOptions) ([]Pipeline, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls PipelinesService.ListAll for the organization.
+func (o *OrgPipelines) ListAll(ctx context.Context, opt *PipelineListOptions) iter.Seq2[Pipeline, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Delete calls PipelinesService.Delete for the organization.
+func (o *OrgPipelines) Delete(ctx context.Context, slug string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, slug)
+}
+
+// Update calls PipelinesService.Update for the organization.
+func (o *OrgPipelines) Update(ctx context.Context, slug string, up UpdatePipeline) (Pipeline, *Response, error) {
+	return o.s.Update(ctx, o.org, slug, up)
+}
+
+// AddWebhook calls PipelinesService.AddWebhook for the organization.
+func (o *OrgPipelines) AddWebhook(ctx context.Context, slug string) (*Response, error) {
+	return o.s.AddWebhook(ctx, o.org, slug)
+}
+
+// Archive calls PipelinesService.Archive for the organization.
+func (o *OrgPipelines) Archive(ctx context.Context, slug string) (*Response, error) {
+	return o.s.Archive(ctx, o.org, slug)
+}
+
+// Unarchive calls PipelinesService.Unarchive for the organization.
+func (o *OrgPipelines) Unarchive(ctx context.Context, slug string) (*Response, error) {
+	return o.s.Unarchive(ctx, o.org, slug)
+}
+
+// OrgRateLimit is RateLimitService bound to an organization.
+type OrgRateLimit struct {
+	s   *RateLimitService
+	org string
+}
+
+// Get calls RateLimitService.Get for the organization.
+func (o *OrgRateLimit) Get(ctx context.Context) (RateLimit, *Response, error) {
+	return o.s.Get(ctx, o.org)
+}
+
+// OrgRules is RulesService bound to an organization.
+type OrgRules struct {
+	s   *RulesService
+	org string
+}
+
+// List calls RulesService.List for the organization.
+func (o *OrgRules) List(ctx context.Context, opt *RulesListOptions) ([]Rule, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls RulesService.ListAll for the organization.
+func (o *OrgRules) ListAll(ctx context.Context, opt *RulesListOptions) iter.Seq2[Rule, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls RulesService.Get for the organization.
+func (o *OrgRules) Get(ctx context.Context, ruleUUID string) (Rule, *Response, error) {
+	return o.s.Get(ctx, o.org, ruleUUID)
+}
+
+// Create calls RulesService.Create for the organization.
+func (o *OrgRules) Create(ctx context.Context, rc RuleCreate) (Rule, *Response, error) {
+	return o.s.Create(ctx, o.org, rc)
+}
+
+// Delete calls RulesService.Delete for the organization.
+func (o *OrgRules) Delete(ctx context.Context, ruleUUID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, ruleUUID)
+}
+
+// OrgStepUploads is StepUploadsService bound to an organization.
+type OrgStepUploads struct {
+	s   *StepUploadsService
+	org string
+}
+
+// ListByBuild calls StepUploadsService.ListByBuild for the organization.
+func (o *OrgStepUploads) ListByBuild(ctx context.Context, pipeline, buildNumber string, opt *StepUploadsListOptions) (StepUploadsList, *Response, error) {
+	return o.s.ListByBuild(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls StepUploadsService.ListByBuildAll for the organization.
+func (o *OrgStepUploads) ListByBuildAll(ctx context.Context, pipeline, buildNumber string, opt *StepUploadsListOptions) iter.Seq2[StepUpload, error] {
+	return o.s.ListByBuildAll(ctx, o.org, pipeline, buildNumber, opt)
+}
+
+// Get calls StepUploadsService.Get for the organization.
+func (o *OrgStepUploads) Get(ctx context.Context, pipeline, buildNumber, uploadUUID string) (StepUpload, *Response, error) {
+	return o.s.Get(ctx, o.org, pipeline, buildNumber, uploadUUID)
+}
+
+// OrgTeamMember is TeamMemberService bound to an organization.
+type OrgTeamMember struct {
+	s   *TeamMemberService
+	org string
+}
+
+// ListTeamMembers calls TeamMemberService.ListTeamMembers for the organization.
+func (o *OrgTeamMember) ListTeamMembers(ctx context.Context, id string, opt *TeamMembersListOptions) ([]TeamMember, *Response, error) {
+	return o.s.ListTeamMembers(ctx, o.org, id, opt)
+}
+
+// ListTeamMembersAll calls TeamMemberService.ListTeamMembersAll for the organization.
+func (o *OrgTeamMember) ListTeamMembersAll(ctx context.Context, id string, opt *TeamMembersListOptions) iter.Seq2[TeamMember, error] {
+	return o.s.ListTeamMembersAll(ctx, o.org, id, opt)
+}
+
+// GetTeamMember calls TeamMemberService.GetTeamMember for the organization.
+func (o *OrgTeamMember) GetTeamMember(ctx context.Context, teamID, userID string) (TeamMember, error) {
+	return o.s.GetTeamMember(ctx, o.org, teamID, userID)
+}
+
+// CreateTeamMember calls TeamMemberService.CreateTeamMember for the organization.
+func (o *OrgTeamMember) CreateTeamMember(ctx context.Context, teamID string, t CreateTeamMember) (TeamMember, *Response, error) {
+	return o.s.CreateTeamMember(ctx, o.org, teamID, t)
+}
+
+// UpdateTeamMember calls TeamMemberService.UpdateTeamMember for the organization.
+func (o *OrgTeamMember) UpdateTeamMember(ctx context.Context, teamID, userID, role string) (TeamMember, *Response, error) {
+	return o.s.UpdateTeamMember(ctx, o.org, teamID, userID, role)
+}
+
+// DeleteTeamMember calls TeamMemberService.DeleteTeamMember for the organization.
+func (o *OrgTeamMember) DeleteTeamMember(ctx context.Context, teamID, userID string) (*Response, error) {
+	return o.s.DeleteTeamMember(ctx, o.org, teamID, userID)
+}
+
+// OrgTeamPipelines is TeamPipelinesService bound to an organization.
+type OrgTeamPipelines struct {
+	s   *TeamPipelinesService
+	org string
+}
+
+// List calls TeamPipelinesService.List for the organization.
+func (o *OrgTeamPipelines) List(ctx context.Context, id string, opt *TeamPipelinesListOptions) ([]TeamPipeline, *Response, error) {
+	return o.s.List(ctx, o.org, id, opt)
+}
+
+// ListAll calls TeamPipelinesService.ListAll for the organization.
+func (o *OrgTeamPipelines) ListAll(ctx context.Context, id string, opt *TeamPipelinesListOptions) iter.Seq2[TeamPipeline, error] {
+	return o.s.ListAll(ctx, o.org, id, opt)
+}
+
+// Get calls TeamPipelinesService.Get for the organization.
+func (o *OrgTeamPipelines) Get(ctx context.Context, teamID, pipelineID string) (TeamPipeline, *Response, error) {
+	return o.s.Get(ctx, o.org, teamID, pipelineID)
+}
+
+// Create calls TeamPipelinesService.Create for the organization.
+func (o *OrgTeamPipelines) Create(ctx context.Context, teamID string, ctp CreateTeamPipelines) (TeamPipeline, *Response, error) {
+	return o.s.Create(ctx, o.org, teamID, ctp)
+}
+
+// Update calls TeamPipelinesService.Update for the organization.
+func (o *OrgTeamPipelines) Update(ctx context.Context, teamID, pipelineID string, utp UpdateTeamPipelines) (TeamPipeline, *Response, error) {
+	return o.s.Update(ctx, o.org, teamID, pipelineID, utp)
+}
+
+// Delete calls TeamPipelinesService.Delete for the organization.
+func (o *OrgTeamPipelines) Delete(ctx context.Context, teamID, pipelineID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, teamID, pipelineID)
+}
+
+// OrgTeamSuites is TeamSuitesService bound to an organization.
+type OrgTeamSuites struct {
+	s   *TeamSuitesService
+	org string
+}
+
+// List calls TeamSuitesService.List for the organization.
+func (o *OrgTeamSuites) List(ctx context.Context, id string, opt *TeamSuitesListOptions) ([]TeamSuites, *Response, error) {
+	return o.s.List(ctx, o.org, id, opt)
+}
+
+// ListAll calls TeamSuitesService.ListAll for the organization.
+func (o *OrgTeamSuites) ListAll(ctx context.Context, id string, opt *TeamSuitesListOptions) iter.Seq2[TeamSuites, error] {
+	return o.s.ListAll(ctx, o.org, id, opt)
+}
+
+// Get calls TeamSuitesService.Get for the organization.
+func (o *OrgTeamSuites) Get(ctx context.Context, teamID, suiteID string) (TeamSuites, *Response, error) {
+	return o.s.Get(ctx, o.org, teamID, suiteID)
+}
+
+// Create calls TeamSuitesService.Create for the organization.
+func (o *OrgTeamSuites) Create(ctx context.Context, teamID string, cts CreateTeamSuites) (TeamSuites, *Response, error) {
+	return o.s.Create(ctx, o.org, teamID, cts)
+}
+
+// Update calls TeamSuitesService.Update for the organization.
+func (o *OrgTeamSuites) Update(ctx context.Context, teamID, pipelineID string, utp UpdateTeamSuites) (TeamSuites, *Response, error) {
+	return o.s.Update(ctx, o.org, teamID, pipelineID, utp)
+}
+
+// Delete calls TeamSuitesService.Delete for the organization.
+func (o *OrgTeamSuites) Delete(ctx context.Context, teamID, suiteID string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, teamID, suiteID)
+}
+
+// OrgTeams is TeamsService bound to an organization.
+type OrgTeams struct {
+	s   *TeamsService
+	org string
+}
+
+// List calls TeamsService.List for the organization.
+func (o *OrgTeams) List(ctx context.Context, opt *TeamsListOptions) ([]Team, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls TeamsService.ListAll for the organization.
+func (o *OrgTeams) ListAll(ctx context.Context, opt *TeamsListOptions) iter.Seq2[Team, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// GetTeam calls TeamsService.GetTeam for the organization.
+func (o *OrgTeams) GetTeam(ctx context.Context, id string) (Team, error) {
+	return o.s.GetTeam(ctx, o.org, id)
+}
+
+// CreateTeam calls TeamsService.CreateTeam for the organization.
+func (o *OrgTeams) CreateTeam(ctx context.Context, t CreateTeam) (Team, *Response, error) {
+	return o.s.CreateTeam(ctx, o.org, t)
+}
+
+// UpdateTeam calls TeamsService.UpdateTeam for the organization.
+func (o *OrgTeams) UpdateTeam(ctx context.Context, id string, t UpdateTeam) (Team, *Response, error) {
+	return o.s.UpdateTeam(ctx, o.org, id, t)
+}
+
+// DeleteTeam calls TeamsService.DeleteTeam for the organization.
+func (o *OrgTeams) DeleteTeam(ctx context.Context, id string) (*Response, error) {
+	return o.s.DeleteTeam(ctx, o.org, id)
+}
+
+// OrgTestRuns is TestRunsService bound to an organization.
+type OrgTestRuns struct {
+	s   *TestRunsService
+	org string
+}
+
+// List calls TestRunsService.List for the organization.
+func (o *OrgTestRuns) List(ctx context.Context, slug string, opt *TestRunsListOptions) ([]TestRun, *Response, error) {
+	return o.s.List(ctx, o.org, slug, opt)
+}
+
+// ListAll calls TestRunsService.ListAll for the organization.
+func (o *OrgTestRuns) ListAll(ctx context.Context, slug string, opt *TestRunsListOptions) iter.Seq2[TestRun, error] {
+	return o.s.ListAll(ctx, o.org, slug, opt)
+}
+
+// Get calls TestRunsService.Get for the organization.
+func (o *OrgTestRuns) Get(ctx context.Context, slug, runID string) (TestRun, *Response, error) {
+	return o.s.Get(ctx, o.org, slug, runID)
+}
+
+// GetFailedExecutions calls TestRunsService.GetFailedExecutions for the organization.
+func (o *OrgTestRuns) GetFailedExecutions(ctx context.Context, slug, runID string, opt *FailedExecutionsOptions) ([]FailedExecution, *Response, error) {
+	return o.s.GetFailedExecutions(ctx, o.org, slug, runID, opt)
+}
+
+// OrgTestSuites is TestSuitesService bound to an organization.
+type OrgTestSuites struct {
+	s   *TestSuitesService
+	org string
+}
+
+// List calls TestSuitesService.List for the organization.
+func (o *OrgTestSuites) List(ctx context.Context, opt *TestSuiteListOptions) ([]TestSuite, *Response, error) {
+	return o.s.List(ctx, o.org, opt)
+}
+
+// ListAll calls TestSuitesService.ListAll for the organization.
+func (o *OrgTestSuites) ListAll(ctx context.Context, opt *TestSuiteListOptions) iter.Seq2[TestSuite, error] {
+	return o.s.ListAll(ctx, o.org, opt)
+}
+
+// Get calls TestSuitesService.Get for the organization.
+func (o *OrgTestSuites) Get(ctx context.Context, slug string) (TestSuite, *Response, error) {
+	return o.s.Get(ctx, o.org, slug)
+}
+
+// Create calls TestSuitesService.Create for the organization.
+func (o *OrgTestSuites) Create(ctx context.Context, ts TestSuiteCreate) (TestSuite, *Response, error) {
+	return o.s.Create(ctx, o.org, ts)
+}
+
+// Update calls TestSuitesService.Update for the organization.
+func (o *OrgTestSuites) Update(ctx context.Context, slug string, ts TestSuiteUpdate) (TestSuite, *Response, error) {
+	return o.s.Update(ctx, o.org, slug, ts)
+}
+
+// Delete calls TestSuitesService.Delete for the organization.
+func (o *OrgTestSuites) Delete(ctx context.Context, slug string) (*Response, error) {
+	return o.s.Delete(ctx, o.org, slug)
+}
+
+// OrgTests is TestsService bound to an organization.
+type OrgTests struct {
+	s   *TestsService
+	org string
+}
+
+// List calls TestsService.List for the organization.
+func (o *OrgTests) List(ctx context.Context, slug string, opt *TestsListOptions) ([]TestWithMetrics, *Response, error) {
+	return o.s.List(ctx, o.org, slug, opt)
+}
+
+// ListAll calls TestsService.ListAll for the organization.
+func (o *OrgTests) ListAll(ctx context.Context, slug string, opt *TestsListOptions) iter.Seq2[TestWithMetrics, error] {
+	return o.s.ListAll(ctx, o.org, slug, opt)
+}
+
+// Get calls TestsService.Get for the organization.
+func (o *OrgTests) Get(ctx context.Context, slug, testID string) (Test, *Response, error) {
+	return o.s.Get(ctx, o.org, slug, testID)
+}
+
+// Find calls TestsService.Find for the organization.
+func (o *OrgTests) Find(ctx context.Context, slug string, find FindTestOptions) (Test, *Response, error) {
+	return o.s.Find(ctx, o.org, slug, find)
+}
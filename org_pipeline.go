@@ -0,0 +1,350 @@
+package buildkite
+
+import (
+	"context"
+	"iter"
+)
+
+// PipelineClient is a view of a Client bound to one pipeline, so the
+// organization and pipeline slugs needn't be passed to every call:
+//
+//	web := client.Org("acme").Pipeline("web")
+//	build, _, err := web.Builds.Get(ctx, "42", nil)
+//	jobs, _, err := web.Jobs.ListByBuild(ctx, "42", nil)
+//
+// Each service has the methods of the Client service that take an
+// organization and a pipeline, with the same arguments after them.
+// BuildsService.ListByPipeline and ListByPipelineAll are List and ListAll,
+// and the PipelinesService methods that act on a single pipeline are methods
+// of PipelineClient itself.
+type PipelineClient struct {
+	client *Client
+	org    string
+	slug   string
+
+	Annotations *PipelineAnnotations
+	Artifacts   *PipelineArtifacts
+	Builds      *PipelineBuilds
+	Jobs        *PipelineJobs
+	Schedules   *PipelineSchedules
+	StepUploads *PipelineStepUploads
+}
+
+// Pipeline returns a view of the client bound to the organization's pipeline
+// with the given slug.
+func (o *OrgClient) Pipeline(slug string) *PipelineClient {
+	return &PipelineClient{
+		client: o.client,
+		org:    o.slug,
+		slug:   slug,
+
+		Annotations: &PipelineAnnotations{s: o.client.Annotations, org: o.slug, pipeline: slug},
+		Artifacts:   &PipelineArtifacts{s: o.client.Artifacts, org: o.slug, pipeline: slug},
+		Builds:      &PipelineBuilds{s: o.client.Builds, org: o.slug, pipeline: slug},
+		Jobs:        &PipelineJobs{s: o.client.Jobs, org: o.slug, pipeline: slug},
+		Schedules:   &PipelineSchedules{s: o.client.PipelineSchedules, org: o.slug, pipeline: slug},
+		StepUploads: &PipelineStepUploads{s: o.client.StepUploads, org: o.slug, pipeline: slug},
+	}
+}
+
+// Org returns the organization's slug.
+func (p *PipelineClient) Org() string {
+	return p.org
+}
+
+// Slug returns the pipeline's slug.
+func (p *PipelineClient) Slug() string {
+	return p.slug
+}
+
+// Get calls PipelinesService.Get for the pipeline.
+func (p *PipelineClient) Get(ctx context.Context) (Pipeline, *Response, error) {
+	return p.client.Pipelines.Get(ctx, p.org, p.slug)
+}
+
+// Delete calls PipelinesService.Delete for the pipeline.
+func (p *PipelineClient) Delete(ctx context.Context) (*Response, error) {
+	return p.client.Pipelines.Delete(ctx, p.org, p.slug)
+}
+
+// Update calls PipelinesService.Update for the pipeline.
+func (p *PipelineClient) Update(ctx context.Context, up UpdatePipeline) (Pipeline, *Response, error) {
+	return p.client.Pipelines.Update(ctx, p.org, p.slug, up)
+}
+
+// AddWebhook calls PipelinesService.AddWebhook for the pipeline.
+func (p *PipelineClient) AddWebhook(ctx context.Context) (*Response, error) {
+	return p.client.Pipelines.AddWebhook(ctx, p.org, p.slug)
+}
+
+// Archive calls PipelinesService.Archive for the pipeline.
+func (p *PipelineClient) Archive(ctx context.Context) (*Response, error) {
+	return p.client.Pipelines.Archive(ctx, p.org, p.slug)
+}
+
+// Unarchive calls PipelinesService.Unarchive for the pipeline.
+func (p *PipelineClient) Unarchive(ctx context.Context) (*Response, error) {
+	return p.client.Pipelines.Unarchive(ctx, p.org, p.slug)
+}
+
+// PipelineAnnotations is AnnotationsService bound to a pipeline.
+type PipelineAnnotations struct {
+	s        *AnnotationsService
+	org      string
+	pipeline string
+}
+
+// ListByBuild calls AnnotationsService.ListByBuild for the pipeline.
+func (p *PipelineAnnotations) ListByBuild(ctx context.Context, build string, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+	return p.s.ListByBuild(ctx, p.org, p.pipeline, build, opt)
+}
+
+// ListByBuildAll calls AnnotationsService.ListByBuildAll for the pipeline.
+func (p *PipelineAnnotations) ListByBuildAll(ctx context.Context, build string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return p.s.ListByBuildAll(ctx, p.org, p.pipeline, build, opt)
+}
+
+// Create calls AnnotationsService.Create for the pipeline.
+func (p *PipelineAnnotations) Create(ctx context.Context, build string, ac AnnotationCreate) (Annotation, *Response, error) {
+	return p.s.Create(ctx, p.org, p.pipeline, build, ac)
+}
+
+// Delete calls AnnotationsService.Delete for the pipeline.
+func (p *PipelineAnnotations) Delete(ctx context.Context, build, annotationUUID string) (*Response, error) {
+	return p.s.Delete(ctx, p.org, p.pipeline, build, annotationUUID)
+}
+
+// ListByJob calls AnnotationsService.ListByJob for the pipeline.
+func (p *PipelineAnnotations) ListByJob(ctx context.Context, build, jobID string, opt *AnnotationListOptions) ([]Annotation, *Response, error) {
+	return p.s.ListByJob(ctx, p.org, p.pipeline, build, jobID, opt)
+}
+
+// ListByJobAll calls AnnotationsService.ListByJobAll for the pipeline.
+func (p *PipelineAnnotations) ListByJobAll(ctx context.Context, build, jobID string, opt *AnnotationListOptions) iter.Seq2[Annotation, error] {
+	return p.s.ListByJobAll(ctx, p.org, p.pipeline, build, jobID, opt)
+}
+
+// CreateForJob calls AnnotationsService.CreateForJob for the pipeline.
+func (p *PipelineAnnotations) CreateForJob(ctx context.Context, build, jobID string, ac AnnotationCreate) (Annotation, *Response, error) {
+	return p.s.CreateForJob(ctx, p.org, p.pipeline, build, jobID, ac)
+}
+
+// DeleteForJob calls AnnotationsService.DeleteForJob for the pipeline.
+func (p *PipelineAnnotations) DeleteForJob(ctx context.Context, build, jobID, annotationUUID string) (*Response, error) {
+	return p.s.DeleteForJob(ctx, p.org, p.pipeline, build, jobID, annotationUUID)
+}
+
+// PipelineArtifacts is ArtifactsService bound to a pipeline.
+type PipelineArtifacts struct {
+	s        *ArtifactsService
+	org      string
+	pipeline string
+}
+
+// ListByBuild calls ArtifactsService.ListByBuild for the pipeline.
+func (p *PipelineArtifacts) ListByBuild(ctx context.Context, build string, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+	return p.s.ListByBuild(ctx, p.org, p.pipeline, build, opt)
+}
+
+// ListByBuildAll calls ArtifactsService.ListByBuildAll for the pipeline.
+func (p *PipelineArtifacts) ListByBuildAll(ctx context.Context, build string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return p.s.ListByBuildAll(ctx, p.org, p.pipeline, build, opt)
+}
+
+// ListByJob calls ArtifactsService.ListByJob for the pipeline.
+func (p *PipelineArtifacts) ListByJob(ctx context.Context, build, job string, opt *ArtifactListOptions) ([]Artifact, *Response, error) {
+	return p.s.ListByJob(ctx, p.org, p.pipeline, build, job, opt)
+}
+
+// ListByJobAll calls ArtifactsService.ListByJobAll for the pipeline.
+func (p *PipelineArtifacts) ListByJobAll(ctx context.Context, build, job string, opt *ArtifactListOptions) iter.Seq2[Artifact, error] {
+	return p.s.ListByJobAll(ctx, p.org, p.pipeline, build, job, opt)
+}
+
+// Get calls ArtifactsService.Get for the pipeline.
+func (p *PipelineArtifacts) Get(ctx context.Context, build, job, id string) (Artifact, *Response, error) {
+	return p.s.Get(ctx, p.org, p.pipeline, build, job, id)
+}
+
+// Delete calls ArtifactsService.Delete for the pipeline.
+func (p *PipelineArtifacts) Delete(ctx context.Context, build, job, id string) (*Response, error) {
+	return p.s.Delete(ctx, p.org, p.pipeline, build, job, id)
+}
+
+// PipelineBuilds is BuildsService bound to a pipeline.
+type PipelineBuilds struct {
+	s        *BuildsService
+	org      string
+	pipeline string
+}
+
+// BulkCancel calls BuildsService.BulkCancel for the pipeline.
+func (p *PipelineBuilds) BulkCancel(ctx context.Context, opt *BulkOptions) (BulkReport, error) {
+	return p.s.BulkCancel(ctx, p.org, p.pipeline, opt)
+}
+
+// BulkRebuild calls BuildsService.BulkRebuild for the pipeline.
+func (p *PipelineBuilds) BulkRebuild(ctx context.Context, opt *BulkOptions) (BulkReport, error) {
+	return p.s.BulkRebuild(ctx, p.org, p.pipeline, opt)
+}
+
+// Cancel calls BuildsService.Cancel for the pipeline.
+func (p *PipelineBuilds) Cancel(ctx context.Context, buildNumber string) (Build, error) {
+	return p.s.Cancel(ctx, p.org, p.pipeline, buildNumber)
+}
+
+// Create calls BuildsService.Create for the pipeline.
+func (p *PipelineBuilds) Create(ctx context.Context, b CreateBuild) (Build, *Response, error) {
+	return p.s.Create(ctx, p.org, p.pipeline, b)
+}
+
+// CreateAndWait calls BuildsService.CreateAndWait for the pipeline.
+func (p *PipelineBuilds) CreateAndWait(ctx context.Context, b CreateBuild, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	return p.s.CreateAndWait(ctx, p.org, p.pipeline, b, opt)
+}
+
+// Get calls BuildsService.Get for the pipeline.
+func (p *PipelineBuilds) Get(ctx context.Context, buildNumber string, opt *BuildGetOptions) (Build, *Response, error) {
+	return p.s.Get(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// List calls BuildsService.ListByPipeline for the pipeline.
+func (p *PipelineBuilds) List(ctx context.Context, opt *BuildsListOptions) ([]Build, *Response, error) {
+	return p.s.ListByPipeline(ctx, p.org, p.pipeline, opt)
+}
+
+// ListAll calls BuildsService.ListByPipelineAll for the pipeline.
+func (p *PipelineBuilds) ListAll(ctx context.Context, opt *BuildsListOptions) iter.Seq2[Build, error] {
+	return p.s.ListByPipelineAll(ctx, p.org, p.pipeline, opt)
+}
+
+// Rebuild calls BuildsService.Rebuild for the pipeline.
+func (p *PipelineBuilds) Rebuild(ctx context.Context, buildNumber string) (Build, error) {
+	return p.s.Rebuild(ctx, p.org, p.pipeline, buildNumber)
+}
+
+// Tree calls BuildsService.Tree for the pipeline.
+func (p *PipelineBuilds) Tree(ctx context.Context, buildNumber string, opt *BuildTreeOptions) (*BuildTree, error) {
+	return p.s.Tree(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// WaitForBuild calls BuildsService.WaitForBuild for the pipeline.
+func (p *PipelineBuilds) WaitForBuild(ctx context.Context, buildNumber string, opt *WaitForBuildOptions) (Build, BuildOutcome, error) {
+	return p.s.WaitForBuild(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// PipelineJobs is JobsService bound to a pipeline.
+type PipelineJobs struct {
+	s        *JobsService
+	org      string
+	pipeline string
+}
+
+// ListByBuild calls JobsService.ListByBuild for the pipeline.
+func (p *PipelineJobs) ListByBuild(ctx context.Context, buildNumber string, opt *JobsListOptions) (JobsList, *Response, error) {
+	return p.s.ListByBuild(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls JobsService.ListByBuildAll for the pipeline.
+func (p *PipelineJobs) ListByBuildAll(ctx context.Context, buildNumber string, opt *JobsListOptions) iter.Seq2[Job, error] {
+	return p.s.ListByBuildAll(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// GetJob calls JobsService.GetJob for the pipeline.
+func (p *PipelineJobs) GetJob(ctx context.Context, buildNumber, jobID string) (Job, *Response, error) {
+	return p.s.GetJob(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// UnblockJob calls JobsService.UnblockJob for the pipeline.
+func (p *PipelineJobs) UnblockJob(ctx context.Context, buildNumber, jobID string, opt *JobUnblockOptions) (Job, *Response, error) {
+	return p.s.UnblockJob(ctx, p.org, p.pipeline, buildNumber, jobID, opt)
+}
+
+// RetryJob calls JobsService.RetryJob for the pipeline.
+func (p *PipelineJobs) RetryJob(ctx context.Context, buildNumber, jobID string) (Job, *Response, error) {
+	return p.s.RetryJob(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// GetJobLog calls JobsService.GetJobLog for the pipeline.
+func (p *PipelineJobs) GetJobLog(ctx context.Context, buildNumber, jobID string) (JobLog, *Response, error) {
+	return p.s.GetJobLog(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// JobLogExists calls JobsService.JobLogExists for the pipeline.
+func (p *PipelineJobs) JobLogExists(ctx context.Context, buildNumber, jobID string) (bool, *Response, error) {
+	return p.s.JobLogExists(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// GetJobEnvironmentVariables calls JobsService.GetJobEnvironmentVariables for the pipeline.
+func (p *PipelineJobs) GetJobEnvironmentVariables(ctx context.Context, buildNumber, jobID string) (JobEnvs, *Response, error) {
+	return p.s.GetJobEnvironmentVariables(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// ReprioritizeJob calls JobsService.ReprioritizeJob for the pipeline.
+func (p *PipelineJobs) ReprioritizeJob(ctx context.Context, buildNumber, jobID string, opt *JobReprioritizationOptions) (Job, *Response, error) {
+	return p.s.ReprioritizeJob(ctx, p.org, p.pipeline, buildNumber, jobID, opt)
+}
+
+// DeleteJobLog calls JobsService.DeleteJobLog for the pipeline.
+func (p *PipelineJobs) DeleteJobLog(ctx context.Context, buildNumber, jobID string) (*Response, error) {
+	return p.s.DeleteJobLog(ctx, p.org, p.pipeline, buildNumber, jobID)
+}
+
+// PipelineSchedules is PipelineSchedulesService bound to a pipeline.
+type PipelineSchedules struct {
+	s        *PipelineSchedulesService
+	org      string
+	pipeline string
+}
+
+// List calls PipelineSchedulesService.List for the pipeline.
+func (p *PipelineSchedules) List(ctx context.Context, opt *PipelineScheduleListOptions) ([]PipelineSchedule, *Response, error) {
+	return p.s.List(ctx, p.org, p.pipeline, opt)
+}
+
+// ListAll calls PipelineSchedulesService.ListAll for the pipeline.
+func (p *PipelineSchedules) ListAll(ctx context.Context, opt *PipelineScheduleListOptions) iter.Seq2[PipelineSchedule, error] {
+	return p.s.ListAll(ctx, p.org, p.pipeline, opt)
+}
+
+// Get calls PipelineSchedulesService.Get for the pipeline.
+func (p *PipelineSchedules) Get(ctx context.Context, id string) (PipelineSchedule, *Response, error) {
+	return p.s.Get(ctx, p.org, p.pipeline, id)
+}
+
+// Create calls PipelineSchedulesService.Create for the pipeline.
+func (p *PipelineSchedules) Create(ctx context.Context, in CreatePipelineSchedule) (PipelineSchedule, *Response, error) {
+	return p.s.Create(ctx, p.org, p.pipeline, in)
+}
+
+// Update calls PipelineSchedulesService.Update for the pipeline.
+func (p *PipelineSchedules) Update(ctx context.Context, id string, in UpdatePipelineSchedule) (PipelineSchedule, *Response, error) {
+	return p.s.Update(ctx, p.org, p.pipeline, id, in)
+}
+
+// Delete calls PipelineSchedulesService.Delete for the pipeline.
+func (p *PipelineSchedules) Delete(ctx context.Context, id string) (*Response, error) {
+	return p.s.Delete(ctx, p.org, p.pipeline, id)
+}
+
+// PipelineStepUploads is StepUploadsService bound to a pipeline.
+type PipelineStepUploads struct {
+	s        *StepUploadsService
+	org      string
+	pipeline string
+}
+
+// ListByBuild calls StepUploadsService.ListByBuild for the pipeline.
+func (p *PipelineStepUploads) ListByBuild(ctx context.Context, buildNumber string, opt *StepUploadsListOptions) (StepUploadsList, *Response, error) {
+	return p.s.ListByBuild(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// ListByBuildAll calls StepUploadsService.ListByBuildAll for the pipeline.
+func (p *PipelineStepUploads) ListByBuildAll(ctx context.Context, buildNumber string, opt *StepUploadsListOptions) iter.Seq2[StepUpload, error] {
+	return p.s.ListByBuildAll(ctx, p.org, p.pipeline, buildNumber, opt)
+}
+
+// Get calls StepUploadsService.Get for the pipeline.
+func (p *PipelineStepUploads) Get(ctx context.Context, buildNumber, uploadUUID string) (StepUpload, *Response, error) {
+	return p.s.Get(ctx, p.org, p.pipeline, buildNumber, uploadUUID)
+}
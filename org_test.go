@@ -0,0 +1,133 @@
+package buildkite
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// TestOrgClient_Complete checks that each service of an OrgClient has every
+// method of the Client service of the same name that takes an organization.
+func TestOrgClient_Complete(t *testing.T) {
+	t.Parallel()
+
+	// Methods whose first string argument isn't an organization.
+	unbound := map[string]bool{"Artifacts.DownloadArtifactByURL": true}
+
+	ctxType := reflect.TypeFor[context.Context]()
+	clientType := reflect.TypeFor[Client]()
+	orgType := reflect.TypeFor[OrgClient]()
+
+	for i := range orgType.NumField() {
+		orgField := orgType.Field(i)
+		if !orgField.IsExported() {
+			continue
+		}
+		clientField, ok := clientType.FieldByName(orgField.Name)
+		if !ok {
+			t.Errorf("OrgClient.%s has no Client service of the same name", orgField.Name)
+			continue
+		}
+
+		for j := range clientField.Type.NumMethod() {
+			m := clientField.Type.Method(j)
+			// The method's first argument is the receiver.
+			if m.Type.NumIn() < 3 || m.Type.In(1) != ctxType || m.Type.In(2).Kind() != reflect.String || unbound[orgField.Name+"."+m.Name] {
+				continue
+			}
+			bound, ok := orgField.Type.MethodByName(m.Name)
+			if !ok {
+				t.Errorf("OrgClient.%s has no %s method", orgField.Name, m.Name)
+				continue
+			}
+			if bound.Type.NumIn() != m.Type.NumIn()-1 {
+				t.Errorf("OrgClient.%s.%s takes %d arguments, want %d", orgField.Name, m.Name, bound.Type.NumIn()-1, m.Type.NumIn()-2)
+			}
+		}
+	}
+}
+
+func TestOrgClient(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(`{"slug":"web"}`))
+	})
+	ms.HandleFunc("/v2/organizations/acme/agents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"name": "ci-1"})
+		_, _ = w.Write([]byte(`[{"name":"ci-1"}]`))
+	})
+
+	acme := client.Org("acme")
+	if acme.Slug() != "acme" {
+		t.Errorf("Slug() = %q, want acme", acme.Slug())
+	}
+
+	pipeline, _, err := acme.Pipelines.Get(context.Background(), "web")
+	if err != nil {
+		t.Fatalf("Pipelines.Get: %v", err)
+	}
+	if pipeline.Slug != "web" {
+		t.Errorf("pipeline slug = %q, want web", pipeline.Slug)
+	}
+
+	agents, _, err := acme.Agents.List(context.Background(), &AgentListOptions{Name: "ci-1"})
+	if err != nil {
+		t.Fatalf("Agents.List: %v", err)
+	}
+	if len(agents) != 1 || agents[0].Name != "ci-1" {
+		t.Errorf("agents = %+v, want one named ci-1", agents)
+	}
+}
+
+func TestPipelineClient(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web/builds/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(`{"number":42}`))
+	})
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"branch[]": "main"})
+		_, _ = w.Write([]byte(`[{"number":42},{"number":41}]`))
+	})
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	web := client.Org("acme").Pipeline("web")
+	if web.Org() != "acme" || web.Slug() != "web" {
+		t.Errorf("Org(), Slug() = %q, %q, want acme, web", web.Org(), web.Slug())
+	}
+
+	build, _, err := web.Builds.Get(context.Background(), "42", nil)
+	if err != nil {
+		t.Fatalf("Builds.Get: %v", err)
+	}
+	if build.Number != 42 {
+		t.Errorf("build number = %d, want 42", build.Number)
+	}
+
+	builds, _, err := web.Builds.List(context.Background(), &BuildsListOptions{Branch: []string{"main"}})
+	if err != nil {
+		t.Fatalf("Builds.List: %v", err)
+	}
+	if len(builds) != 2 {
+		t.Errorf("len(builds) = %d, want 2", len(builds))
+	}
+
+	if _, err := web.Archive(context.Background()); err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+}
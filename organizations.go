@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // OrganizationsService handles communication with the organization related
@@ -56,6 +57,14 @@ func (os *OrganizationsService) List(ctx context.Context, opt *OrganizationListO
 	return orgs, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (os *OrganizationsService) ListAll(ctx context.Context, opt *OrganizationListOptions) iter.Seq2[Organization, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *OrganizationListOptions) ([]Organization, *Response, error) {
+		return os.List(ctx, opt)
+	})
+}
+
 // Get fetches an organization
 //
 // buildkite API docs: https://buildkite.com/docs/api/organizations#get-an-organization
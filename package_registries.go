@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -189,6 +190,17 @@ func (rs *PackageRegistriesService) ListPackages(ctx context.Context, organizati
 	return packages, resp, nil
 }
 
+// ListPackagesAll is like ListPackages but returns an iterator over the
+// packages on every page. Iteration moves forward through Links.Next, or
+// backward through Links.Previous when opts.Before is set.
+func (rs *PackageRegistriesService) ListPackagesAll(ctx context.Context, organizationSlug, registrySlug string, opts *RegistryPackagesOptions) iter.Seq2[Package, error] {
+	backward := opts != nil && opts.Before != ""
+	return allCursorPages(ctx, opts, backward, func(ctx context.Context, opts *RegistryPackagesOptions) ([]Package, RegistryPackagesLink, RegistryPackagesLink, error) {
+		packages, _, err := rs.ListPackages(ctx, organizationSlug, registrySlug, opts)
+		return packages.Items, packages.Links.Previous, packages.Links.Next, err
+	})
+}
+
 // Delete deletes a package registry for an organization
 func (rs *PackageRegistriesService) Delete(ctx context.Context, organizationSlug, registrySlug string) (*Response, error) {
 	u := fmt.Sprintf("v2/packages/organizations/%s/registries/%s", organizationSlug, registrySlug)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -201,3 +202,49 @@ func TestPackageRegistryDelete(t *testing.T) {
 		t.Fatalf("client.PackageRegistriesService.Delete(context.Background(),%q, %q) status: %d, want %d", "test-org", "my-cool-registry", got, want)
 	}
 }
+
+func TestPackageRegistryListPackagesAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	const base = "https://api.buildkite.com/v2/packages/organizations/test-org/registries/my-cool-registry/packages"
+
+	server.HandleFunc("/v2/packages/organizations/test-org/registries/my-cool-registry/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		q := r.URL.Query()
+		switch {
+		case q.Get("after") == "" && q.Get("before") == "":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"pkg-1"},{"id":"pkg-2"}],"links":{"next":"%s?after=cursor-2"}}`, base)
+		case q.Get("after") == "cursor-2":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"pkg-3"}],"links":{"prev":"%s?before=cursor-3"}}`, base)
+		case q.Get("before") == "cursor-3":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"pkg-2"}],"links":{"prev":"%s?before=cursor-2","next":"%s?after=cursor-2"}}`, base, base)
+		case q.Get("before") == "cursor-2":
+			_, _ = fmt.Fprintf(w, `{"items":[{"id":"pkg-1"}],"links":{"next":"%s?after=cursor-1"}}`, base)
+		default:
+			t.Fatalf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	collect := func(opts *RegistryPackagesOptions) []string {
+		var ids []string
+		for pkg, err := range client.PackageRegistriesService.ListPackagesAll(context.Background(), "test-org", "my-cool-registry", opts) {
+			if err != nil {
+				t.Fatalf("ListPackagesAll returned error: %v", err)
+			}
+			ids = append(ids, pkg.ID)
+		}
+		return ids
+	}
+
+	if diff := cmp.Diff(collect(nil), []string{"pkg-1", "pkg-2", "pkg-3"}); diff != "" {
+		t.Errorf("ListPackagesAll forward diff: (-got +want)\n%s", diff)
+	}
+
+	if diff := cmp.Diff(collect(&RegistryPackagesOptions{Before: "cursor-3"}), []string{"pkg-2", "pkg-1"}); diff != "" {
+		t.Errorf("ListPackagesAll backward diff: (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,127 @@
+package buildkite
+
+import (
+	"context"
+	"iter"
+)
+
+// pager is satisfied by every options struct that embeds ListOptions, via the
+// promoted listOptions method.
+type pager interface {
+	listOptions() *ListOptions
+}
+
+func (o *ListOptions) listOptions() *ListOptions {
+	return o
+}
+
+// listPage fetches a single page of results using the provided options.
+type listPage[T any, P any] func(ctx context.Context, opt P) ([]T, *Response, error)
+
+// allPages returns an iterator over every item of a page-numbered List method.
+// It starts at the page set in opt (or the first page) and follows
+// Response.NextPage until the API reports no further pages. opt is copied
+// before the first request, so the caller's value is never modified.
+//
+// Iteration stops after yielding an error, which is either the error returned
+// by list or the context's error if ctx is done before a page is fetched.
+func allPages[T any, O any, P interface {
+	*O
+	pager
+}](ctx context.Context, opt P, list listPage[T, P]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		page := P(new(O))
+		if opt != nil {
+			*page = *opt
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, resp, err := list(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if resp == nil || resp.NextPage == 0 {
+				return
+			}
+			page.listOptions().Page = resp.NextPage
+		}
+	}
+}
+
+// cursorLink is satisfied by the Links fields of cursor-paginated lists, such
+// as JobsListLink, which can be turned back into options for the next request.
+type cursorLink[O any] interface {
+	~string
+	ToOptions() (*O, error)
+}
+
+// listCursorPage fetches a single page of a cursor-paginated list, returning
+// its items along with the links to the previous and next pages.
+type listCursorPage[T any, O any, L cursorLink[O]] func(ctx context.Context, opt *O) (items []T, prev, next L, err error)
+
+// allCursorPages returns an iterator over every item of a cursor-paginated
+// List method. It starts from the cursor set in opt and follows the next link
+// of each page, or the previous link when backward is true, until a page has
+// no link in that direction. opt itself is never modified.
+//
+// Iteration stops after yielding an error, which is the error returned by
+// list, the error parsing a link, or the context's error if ctx is done before
+// a page is fetched.
+func allCursorPages[T any, O any, L cursorLink[O]](ctx context.Context, opt *O, backward bool, list listCursorPage[T, O, L]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		page := new(O)
+		if opt != nil {
+			*page = *opt
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, prev, next, err := list(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			link := next
+			if backward {
+				link = prev
+			}
+			if link == "" {
+				return
+			}
+
+			page, err = link.ToOptions()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package buildkite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handlePages serves a fixed set of pages of builds, setting a Link header
+// with rel="next" on every page but the last.
+func handlePages(t *testing.T, server *mockServer, path string, pages [][]int) {
+	t.Helper()
+
+	server.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			_, _ = fmt.Sscan(p, &page)
+		}
+		if page < 1 || page > len(pages) {
+			t.Fatalf("unexpected page %d", page)
+		}
+
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.buildkite.com%s?page=%d>; rel="next"`, path, page+1))
+		}
+
+		builds := make([]Build, 0, len(pages[page-1]))
+		for _, n := range pages[page-1] {
+			builds = append(builds, Build{Number: n})
+		}
+		_ = json.NewEncoder(w).Encode(builds)
+	})
+}
+
+func TestBuildsService_ListByPipelineAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	handlePages(t, server, "/v2/organizations/my-great-org/pipelines/sup-keith/builds", [][]int{{1, 2}, {3, 4}, {5}})
+
+	opt := &BuildsListOptions{Branch: []string{"main"}}
+
+	var got []int
+	for build, err := range client.Builds.ListByPipelineAll(context.Background(), "my-great-org", "sup-keith", opt) {
+		if err != nil {
+			t.Fatalf("Builds.ListByPipelineAll returned error: %v", err)
+		}
+		got = append(got, build.Number)
+	}
+
+	if diff := cmp.Diff(got, []int{1, 2, 3, 4, 5}); diff != "" {
+		t.Errorf("Builds.ListByPipelineAll diff: (-got +want)\n%s", diff)
+	}
+
+	if opt.Page != 0 {
+		t.Errorf("Builds.ListByPipelineAll modified caller's options: Page = %d", opt.Page)
+	}
+
+	if len(server.calls) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(server.calls))
+	}
+}
+
+func TestBuildsService_ListByPipelineAll_StopsOnBreak(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	handlePages(t, server, "/v2/organizations/my-great-org/pipelines/sup-keith/builds", [][]int{{1, 2}, {3, 4}})
+
+	for build, err := range client.Builds.ListByPipelineAll(context.Background(), "my-great-org", "sup-keith", nil) {
+		if err != nil {
+			t.Fatalf("Builds.ListByPipelineAll returned error: %v", err)
+		}
+		if build.Number == 1 {
+			break
+		}
+	}
+
+	if len(server.calls) != 1 {
+		t.Errorf("expected 1 request after breaking on the first page, got %d", len(server.calls))
+	}
+}
+
+func TestBuildsService_ListByPipelineAll_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	handlePages(t, server, "/v2/organizations/my-great-org/pipelines/sup-keith/builds", [][]int{{1, 2}, {3, 4}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []int
+	var gotErr error
+	for build, err := range client.Builds.ListByPipelineAll(ctx, "my-great-org", "sup-keith", nil) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, build.Number)
+		cancel()
+	}
+
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", gotErr)
+	}
+	if diff := cmp.Diff(got, []int{1, 2}); diff != "" {
+		t.Errorf("Builds.ListByPipelineAll diff: (-got +want)\n%s", diff)
+	}
+	if len(server.calls) != 1 {
+		t.Errorf("expected 1 request before cancellation, got %d", len(server.calls))
+	}
+}
+
+func TestAgentsService_ListAll_Error(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/my-great-org/agents", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"message":"Not Found"}`)
+	})
+
+	var errs int
+	for _, err := range client.Agents.ListAll(context.Background(), "my-great-org", nil) {
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("expected *ErrorResponse, got %v", err)
+		}
+		errs++
+	}
+
+	if errs != 1 {
+		t.Errorf("expected a single error to be yielded, got %d", errs)
+	}
+}
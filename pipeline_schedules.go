@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // PipelineSchedulesService handles communication with the pipeline schedule
@@ -85,6 +86,14 @@ func (pss *PipelineSchedulesService) List(ctx context.Context, org, pipelineSlug
 	return schedules, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (pss *PipelineSchedulesService) ListAll(ctx context.Context, org, pipelineSlug string, opt *PipelineScheduleListOptions) iter.Seq2[PipelineSchedule, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *PipelineScheduleListOptions) ([]PipelineSchedule, *Response, error) {
+		return pss.List(ctx, org, pipelineSlug, opt)
+	})
+}
+
 // Get a pipeline schedule by ID.
 //
 // buildkite API docs: https://buildkite.com/docs/apis/rest-api/pipeline-schedules#get-a-pipeline-schedule
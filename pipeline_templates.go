@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // PipelineTemplatesService handles communication with pipeline template related
@@ -81,6 +82,14 @@ func (pts *PipelineTemplatesService) List(ctx context.Context, org string, opt *
 	return templates, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (pts *PipelineTemplatesService) ListAll(ctx context.Context, org string, opt *PipelineTemplateListOptions) iter.Seq2[PipelineTemplate, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *PipelineTemplateListOptions) ([]PipelineTemplate, *Response, error) {
+		return pts.List(ctx, org, opt)
+	})
+}
+
 func (pts *PipelineTemplatesService) Get(ctx context.Context, org, templateUUID string) (PipelineTemplate, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/pipeline-templates/%s", org, templateUUID)
 	req, err := pts.client.NewRequest(ctx, "GET", u, nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 )
 
 // PipelinesService handles communication with the pipeline related
@@ -227,6 +228,14 @@ func (ps *PipelinesService) List(ctx context.Context, org string, opt *PipelineL
 	return pipelines, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (ps *PipelinesService) ListAll(ctx context.Context, org string, opt *PipelineListOptions) iter.Seq2[Pipeline, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *PipelineListOptions) ([]Pipeline, *Response, error) {
+		return ps.List(ctx, org, opt)
+	})
+}
+
 // Delete a pipeline.
 //
 // buildkite API docs: https://buildkite.com/docs/rest-api/pipelines#delete-a-pipeline
@@ -3,6 +3,8 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -52,3 +54,43 @@ func (rls *RateLimitService) Get(ctx context.Context, org string) (RateLimit, *R
 
 	return rateLimit, resp, err
 }
+
+// Rate is the REST API rate limit budget reported by the RateLimit-* headers
+// of a response. Limit is 0 when the response carried no rate limit headers.
+type Rate struct {
+	// Limit is the number of requests allowed in the current window, from
+	// RateLimit-Limit.
+	Limit int
+
+	// Remaining is the number of requests left in the current window, from
+	// RateLimit-Remaining.
+	Remaining int
+
+	// Reset is the time until the current window resets, from RateLimit-Reset.
+	Reset time.Duration
+
+	// ResetAt is the time the current window resets, computed from Reset when
+	// the response was received.
+	ResetAt time.Time
+}
+
+// parseRate reads the RateLimit-* headers from h. ok is false unless both
+// RateLimit-Limit and RateLimit-Remaining are present and valid.
+func parseRate(h http.Header, now time.Time) (rate Rate, ok bool) {
+	limit, err := strconv.Atoi(h.Get("RateLimit-Limit"))
+	if err != nil {
+		return Rate{}, false
+	}
+	remaining, err := strconv.Atoi(h.Get("RateLimit-Remaining"))
+	if err != nil {
+		return Rate{}, false
+	}
+
+	rate = Rate{Limit: limit, Remaining: remaining}
+	if secs, err := strconv.Atoi(h.Get("RateLimit-Reset")); err == nil && secs >= 0 {
+		rate.Reset = time.Duration(secs) * time.Second
+		rate.ResetAt = now.Add(rate.Reset)
+	}
+
+	return rate, true
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestRateLimitService_Get(t *testing.T) {
@@ -64,3 +65,80 @@ func TestRateLimitService_Get(t *testing.T) {
 		t.Errorf("RateLimit.Get diff: (-got +want)\n%s", diff)
 	}
 }
+
+func TestResponse_Rate(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/mock-kite/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "200")
+		w.Header().Set("RateLimit-Remaining", "150")
+		w.Header().Set("RateLimit-Reset", "42")
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	before := time.Now()
+	_, resp, err := client.Pipelines.List(context.Background(), "mock-kite", nil)
+	if err != nil {
+		t.Fatalf("Pipelines.List returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(resp.Rate, Rate{Limit: 200, Remaining: 150, Reset: 42 * time.Second}, cmpopts.IgnoreFields(Rate{}, "ResetAt")); diff != "" {
+		t.Errorf("Response.Rate diff: (-got +want)\n%s", diff)
+	}
+	if resp.Rate.ResetAt.Before(before.Add(42 * time.Second)) {
+		t.Errorf("Response.Rate.ResetAt = %v, want at least 42s after %v", resp.Rate.ResetAt, before)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.December, 15, 5, 40, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    Rate
+		wantOK  bool
+	}{
+		{
+			name:    "all headers",
+			headers: map[string]string{"RateLimit-Limit": "200", "RateLimit-Remaining": "0", "RateLimit-Reset": "30"},
+			want:    Rate{Limit: 200, Remaining: 0, Reset: 30 * time.Second, ResetAt: now.Add(30 * time.Second)},
+			wantOK:  true,
+		},
+		{
+			name:    "missing reset",
+			headers: map[string]string{"RateLimit-Limit": "200", "RateLimit-Remaining": "10"},
+			want:    Rate{Limit: 200, Remaining: 10},
+			wantOK:  true,
+		},
+		{
+			name:    "missing remaining",
+			headers: map[string]string{"RateLimit-Limit": "200"},
+		},
+		{
+			name: "no headers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+
+			got, ok := parseRate(h, now)
+			if ok != tt.wantOK {
+				t.Errorf("parseRate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("parseRate() diff: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
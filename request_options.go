@@ -0,0 +1,112 @@
+package buildkite
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"time"
+)
+
+// RequestOption configures the requests made with a context returned by
+// WithRequestOptions, overriding the Client's configuration for them alone.
+type RequestOption func(*requestOptions)
+
+// requestOptions is the per-call configuration carried by a context.
+type requestOptions struct {
+	header     http.Header
+	maxRetries *int
+	idempotent *bool
+	timeout    time.Duration
+}
+
+// requestOptionsKey is the context key for *requestOptions.
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts, which apply to every
+// request made with it, on top of any options ctx already carries. Pass it to
+// a service method to configure that one call:
+//
+//	ctx := buildkite.WithRequestOptions(ctx, buildkite.WithoutRetry())
+//	build, _, err := client.Builds.Create(ctx, org, pipeline, create)
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	o := &requestOptions{}
+	if parent := requestOptionsFrom(ctx); parent != nil {
+		*o = *parent
+		o.header = parent.header.Clone()
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+func requestOptionsFrom(ctx context.Context) *requestOptions {
+	o, _ := ctx.Value(requestOptionsKey{}).(*requestOptions)
+	return o
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// client.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.header == nil {
+			o.header = http.Header{}
+		}
+		o.header.Set(key, value)
+	}
+}
+
+// WithAPIVersion sets the Buildkite-Version header, which opts the request in
+// to a versioned response format.
+func WithAPIVersion(version string) RequestOption {
+	return WithHeader("Buildkite-Version", version)
+}
+
+// WithMaxRetriesForCall sets the maximum number of retries for the request,
+// in place of the client's WithMaxRetries setting. Negative values are
+// treated as 0.
+func WithMaxRetriesForCall(n int) RequestOption {
+	return func(o *requestOptions) {
+		n = max(n, 0)
+		o.maxRetries = &n
+	}
+}
+
+// WithoutRetry disables retries for the request, including after a 429, such
+// as for a Create that must not be repeated.
+func WithoutRetry() RequestOption {
+	return WithMaxRetriesForCall(0)
+}
+
+// WithIdempotent overrides whether the request is treated as idempotent by
+// the client's RetryPolicy. By default GET, HEAD and OPTIONS requests and
+// GraphQL queries are, and others are only when RetryPolicy.NonIdempotent is
+// set. It has no effect on retries after a 429, which the API returns before
+// acting on a request.
+func WithIdempotent(idempotent bool) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotent = &idempotent
+	}
+}
+
+// WithTimeoutForCall bounds the time the request may take, including retries
+// and reading the response, as if ctx had a deadline that much later.
+func WithTimeoutForCall(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// apply sets the options' headers on req.
+func (o *requestOptions) apply(req *http.Request) {
+	maps.Copy(req.Header, o.header)
+}
+
+// maxRetriesOr returns the request's maximum number of retries, or def if it
+// doesn't override it.
+func (o *requestOptions) maxRetriesOr(def int) int {
+	if o == nil || o.maxRetries == nil {
+		return def
+	}
+	return *o.maxRetries
+}
@@ -0,0 +1,138 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRequestOptions_Headers(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/analytics/organizations/acme/suites/web/tests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Buildkite-Version"); got != "2099-01-01" {
+			t.Errorf("Buildkite-Version = %q, want 2099-01-01", got)
+		}
+		if got := r.Header.Get("X-Trace"); got != "abc" {
+			t.Errorf("X-Trace = %q, want abc", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "override" {
+			t.Errorf("User-Agent = %q, want override", got)
+		}
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithHeader("X-Trace", "abc"))
+	ctx = WithRequestOptions(ctx, WithAPIVersion("2099-01-01"), WithHeader("User-Agent", "override"))
+	if _, _, err := client.Tests.List(ctx, "acme", "web", nil); err != nil {
+		t.Fatalf("Tests.List: %v", err)
+	}
+}
+
+func TestRequestOptions_WithoutRetry(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+	client.retryPolicy = DefaultRetryPolicy()
+	client.retryPolicy.NonIdempotent = true
+
+	calls := 0
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithoutRetry())
+	req, err := client.NewRequest(ctx, http.MethodPost, "/test", map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Do: want error for 503")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRequestOptions_MaxRetriesForCall(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+
+	calls := 0
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithMaxRetriesForCall(1))
+	req, err := client.NewRequest(ctx, http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); err == nil {
+		t.Fatal("Do: want error for 429")
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestRequestOptions_Idempotent(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newRetryTestClient(t)
+	t.Cleanup(teardown)
+	client.retryPolicy = DefaultRetryPolicy()
+
+	calls := 0
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithIdempotent(true))
+	req, err := client.NewRequest(ctx, http.MethodPut, "/test", map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestRequestOptions_Timeout(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithTimeoutForCall(50*time.Millisecond))
+	req, err := client.NewRequest(ctx, http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do error = %v, want context.DeadlineExceeded", err)
+	}
+}
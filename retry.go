@@ -0,0 +1,247 @@
+package buildkite
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// errServerError is returned from the roko callback to signal a retryable 5xx.
+// Like errRateLimited, it is never surfaced to callers.
+var errServerError = errors.New("server error")
+
+// RetryPolicy controls which failures other than rate limiting are retried by
+// Client.Do. Rate-limited (429) requests are always retried, whatever the
+// policy. The zero RetryPolicy retries nothing else, which is the default.
+//
+// Retries under a policy share the attempt budget set by WithMaxRetries with
+// rate-limit retries.
+type RetryPolicy struct {
+	// StatusCodes lists the 5xx response statuses to retry, such as 502, 503 and
+	// 504 from a load balancer. A Retry-After header on these responses, in
+	// either delta-seconds or HTTP-date form, sets the delay before the next
+	// attempt; otherwise capped exponential backoff is used.
+	StatusCodes []int
+
+	// NetworkErrors retries requests that fail without a response because of a
+	// timeout, a connection reset or a connection closed mid-response. Errors
+	// caused by the request's context being done are never retried.
+	NetworkErrors bool
+
+	// NonIdempotent extends the policy to POST, PUT, PATCH and DELETE
+	// requests. By default only GET, HEAD and OPTIONS requests, and GraphQL
+	// queries, are retried under the policy, since a failed non-idempotent
+	// request may still have taken effect on the server.
+	NonIdempotent bool
+
+	// Notify, if set, is called each time a failure covered by the policy is
+	// received, with the same attempt and delay semantics as RateLimitNotify.
+	// statusCode is the response status, or 0 when err holds the network
+	// error that prevented a response.
+	Notify RetryNotify
+}
+
+// RetryNotify is called for each failure retried under a RetryPolicy,
+// including on the final exhausted attempt. attempt is 1-based; delay is the
+// back-off before the next retry, or 0 if this is the final attempt.
+type RetryNotify func(attempt int, delay time.Duration, statusCode int, err error)
+
+// DefaultRetryPolicy returns a RetryPolicy that retries idempotent requests on
+// 502, 503 and 504 responses and on transient network errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		StatusCodes:   []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		NetworkErrors: true,
+	}
+}
+
+// idempotentKey is the context key marking a request as idempotent although
+// its method is not, such as a GraphQL query sent by POST.
+type idempotentKey struct{}
+
+// covers reports whether req may be retried under the policy.
+func (p RetryPolicy) covers(req *http.Request) bool {
+	if opts := requestOptionsFrom(req.Context()); opts != nil && opts.idempotent != nil {
+		return *opts.idempotent
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if idempotent, _ := req.Context().Value(idempotentKey{}).(bool); idempotent {
+		return true
+	}
+	return p.NonIdempotent
+}
+
+// retriesStatus reports whether a response with the given status should be
+// retried under the policy.
+func (p RetryPolicy) retriesStatus(req *http.Request, status int) bool {
+	return p.covers(req) && slices.Contains(p.StatusCodes, status)
+}
+
+// retriesError reports whether a failed round trip should be retried under
+// the policy.
+func (p RetryPolicy) retriesError(req *http.Request, err error) bool {
+	if !p.NetworkErrors || !p.covers(req) || req.Context().Err() != nil {
+		return false
+	}
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError reports whether err is a failure that is likely to
+// succeed if the request is repeated.
+func isTransientNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// serverErrorRetryDelay returns how long to wait before the next attempt after
+// a retryable 5xx. Retry-After is honoured as delta-seconds or an HTTP-date,
+// capped at 120 seconds like RateLimit-Reset. Falls back to the same capped
+// exponential backoff as retryDelay when the header is absent or invalid.
+func serverErrorRetryDelay(resp *http.Response, attempt int) time.Duration {
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		d := time.Duration(-1)
+		if secs, err := strconv.Atoi(s); err == nil && secs >= 0 {
+			d = time.Duration(secs) * time.Second
+		} else if t, err := http.ParseTime(s); err == nil {
+			d = max(time.Until(t), 0)
+		}
+		if d >= 0 {
+			return min(d, 120*time.Second)
+		}
+	}
+	return backoffDelay(attempt)
+}
+
+// ErrRetryBudgetExceeded is matched by the *RetryBudgetError returned when a
+// request is abandoned because retrying it would overrun the budget set by
+// WithMaxRetryWait.
+var ErrRetryBudgetExceeded = errors.New("buildkite: retry wait budget exceeded")
+
+// RetryBudgetError is returned by Client.Do when a request fails and the
+// delay before the next retry would take the total back-off past the budget
+// set by WithMaxRetryWait. Err is the last failure, such as a 429
+// *ErrorResponse or a network error, so errors.Is(err, ErrRateLimited) still
+// matches a rate-limited request.
+type RetryBudgetError struct {
+	Budget time.Duration // the budget set by WithMaxRetryWait
+	Waited time.Duration // the back-off already spent on the request
+	Delay  time.Duration // the back-off the next retry needed
+	Err    error
+}
+
+func (e *RetryBudgetError) Error() string {
+	return fmt.Sprintf("buildkite: not retrying after %v, which would exceed retry wait budget of %v (waited %v): %v", e.Delay, e.Budget, e.Waited, e.Err)
+}
+
+func (e *RetryBudgetError) Unwrap() error { return e.Err }
+
+// Is reports whether target is ErrRetryBudgetExceeded.
+func (e *RetryBudgetError) Is(target error) bool {
+	return target == ErrRetryBudgetExceeded
+}
+
+// retryBudget tracks the back-off spent on a request against the limit set by
+// WithMaxRetryWait.
+type retryBudget struct {
+	limit   time.Duration // 0 for no limit
+	waited  time.Duration
+	refused time.Duration // the delay refused, if any
+}
+
+// take reports whether a retry after delay fits in the budget, spending it if
+// so.
+func (b *retryBudget) take(delay time.Duration) bool {
+	if b.limit > 0 && b.waited+delay > b.limit {
+		b.refused = delay
+		return false
+	}
+	b.waited += delay
+	return true
+}
+
+// exceeded reports whether a retry was refused for lack of budget.
+func (b *retryBudget) exceeded() bool {
+	return b.refused > 0
+}
+
+// wrap returns err as a *RetryBudgetError if a retry was refused for lack of
+// budget, or unchanged otherwise.
+func (b *retryBudget) wrap(err error) error {
+	if err == nil || !b.exceeded() {
+		return err
+	}
+	return &RetryBudgetError{Budget: b.limit, Waited: b.waited, Delay: b.refused, Err: err}
+}
+
+// RetryEvent describes a failed attempt at a request that Client.Do may
+// retry, as passed to a RetryEventNotify.
+type RetryEvent struct {
+	Method  string // the request method
+	Path    string // the request URL path, such as /v2/organizations/acme/builds
+	Attempt int    // 1-based
+
+	// StatusCode is the response status, such as 429, or 0 when Err holds the
+	// network error that prevented a response.
+	StatusCode int
+	Err        error
+
+	// Rate is read from the response's RateLimit-* headers, including the
+	// time until the window resets. It is zero if they are absent.
+	Rate Rate
+
+	// Retrying reports whether another attempt follows, after Delay.
+	Retrying bool
+	Delay    time.Duration
+
+	// BudgetExceeded is set when no attempt follows because Delay would have
+	// overrun the budget set by WithMaxRetryWait; Delay is then the delay
+	// that was refused.
+	BudgetExceeded bool
+}
+
+// RetryEventNotify is called for each failed attempt that Client.Do may retry.
+type RetryEventNotify func(RetryEvent)
+
+// newRetryEvent describes a failed attempt at req, which got resp or err.
+func newRetryEvent(req *http.Request, resp *http.Response, err error, attempt int, delay time.Duration, retrying bool, budget *retryBudget) RetryEvent {
+	ev := RetryEvent{
+		Method:         req.Method,
+		Path:           req.URL.Path,
+		Attempt:        attempt,
+		Err:            err,
+		Retrying:       retrying,
+		Delay:          delay,
+		BudgetExceeded: budget.exceeded(),
+	}
+	if ev.BudgetExceeded {
+		ev.Delay = budget.refused
+	}
+	if resp != nil {
+		ev.StatusCode = resp.StatusCode
+		ev.Rate, _ = parseRate(resp.Header, time.Now())
+	}
+	return ev
+}
+
+// notifyRetry passes ev to the callback registered with WithRetryEventNotify,
+// if any.
+func (c *Client) notifyRetry(ev RetryEvent) {
+	if c.retryNotify != nil {
+		c.retryNotify(ev)
+	}
+}
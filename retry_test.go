@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -491,3 +492,350 @@ func TestRetryDelay(t *testing.T) {
 		})
 	}
 }
+
+// roundTripFunc adapts a function to an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// TestRetryPolicy_RetriesServerErrors verifies that statuses listed in the
+// policy are retried for idempotent requests, with Notify fired on each failure.
+func TestRetryPolicy_RetriesServerErrors(t *testing.T) {
+	callCount := 0
+
+	ms, client, teardown := newRetryTestClient(t)
+	defer teardown()
+
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		switch callCount {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	var notified []int
+	policy := DefaultRetryPolicy()
+	policy.Notify = func(attempt int, _ time.Duration, statusCode int, err error) {
+		if err != nil {
+			t.Errorf("unexpected network error in notify: %v", err)
+		}
+		notified = append(notified, statusCode)
+	}
+	if err := WithRetryPolicy(policy)(client); err != nil {
+		t.Fatalf("WithRetryPolicy: %v", err)
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if callCount != 3 {
+		t.Errorf("expected 3 server calls, got %d", callCount)
+	}
+	if len(notified) != 2 || notified[0] != http.StatusBadGateway || notified[1] != http.StatusServiceUnavailable {
+		t.Errorf("expected notify for 502 then 503, got %v", notified)
+	}
+}
+
+// TestRetryPolicy_NotSetByDefault verifies a client without a retry policy
+// surfaces a 503 immediately.
+func TestRetryPolicy_NotSetByDefault(t *testing.T) {
+	callCount := 0
+
+	ms, client, teardown := newRetryTestClient(t)
+	defer teardown()
+
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = fmt.Fprint(w, `{"message":"unavailable"}`)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	_, err = client.Do(req, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 *ErrorResponse, got %v", err)
+	}
+	if callCount != 1 {
+		t.Errorf("expected 1 server call, got %d", callCount)
+	}
+}
+
+// TestRetryPolicy_NonIdempotent verifies POST requests are only retried on
+// server errors when the policy opts in.
+func TestRetryPolicy_NonIdempotent(t *testing.T) {
+	for _, nonIdempotent := range []bool{false, true} {
+		t.Run(fmt.Sprintf("NonIdempotent=%t", nonIdempotent), func(t *testing.T) {
+			callCount := 0
+
+			ms, client, teardown := newRetryTestClient(t)
+			defer teardown()
+
+			ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+				callCount++
+				if callCount == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					_, _ = fmt.Fprint(w, `{"message":"bad gateway"}`)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+
+			policy := DefaultRetryPolicy()
+			policy.NonIdempotent = nonIdempotent
+			if err := WithRetryPolicy(policy)(client); err != nil {
+				t.Fatalf("WithRetryPolicy: %v", err)
+			}
+
+			req, err := client.NewRequest(context.Background(), http.MethodPost, "/test", map[string]string{"name": "x"})
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+
+			_, err = client.Do(req, nil)
+			if nonIdempotent {
+				if err != nil || callCount != 2 {
+					t.Errorf("expected POST to be retried, got err=%v calls=%d", err, callCount)
+				}
+			} else {
+				if err == nil || callCount != 1 {
+					t.Errorf("expected POST not to be retried, got err=%v calls=%d", err, callCount)
+				}
+			}
+		})
+	}
+}
+
+// TestRetryPolicy_RetryAfter verifies the Retry-After header sets the delay
+// before retrying a server error.
+func TestRetryPolicy_RetryAfter(t *testing.T) {
+	callCount := 0
+
+	ms, client, teardown := newMockServerAndClient(t)
+	defer teardown()
+
+	var slept []time.Duration
+	client.sleepFunc = func(d time.Duration) { slept = append(slept, d) }
+
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if callCount == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := WithRetryPolicy(DefaultRetryPolicy())(client); err != nil {
+		t.Fatalf("WithRetryPolicy: %v", err)
+	}
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(slept) != 1 || slept[0] != 7*time.Second {
+		t.Errorf("expected a single 7s sleep, got %v", slept)
+	}
+}
+
+// TestRetryPolicy_NetworkErrors verifies connection resets are retried when
+// the policy covers network errors.
+func TestRetryPolicy_NetworkErrors(t *testing.T) {
+	callCount := 0
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			callCount++
+			if callCount == 1 {
+				return nil, fmt.Errorf("read tcp: %w", syscall.ECONNRESET)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+				Request:    r,
+			}, nil
+		}),
+	}
+
+	var notifyErr error
+	policy := DefaultRetryPolicy()
+	policy.Notify = func(_ int, _ time.Duration, statusCode int, err error) {
+		if statusCode != 0 {
+			t.Errorf("expected status 0 for network error, got %d", statusCode)
+		}
+		notifyErr = err
+	}
+
+	client, err := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.sleepFunc = func(time.Duration) {}
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if callCount != 2 {
+		t.Errorf("expected 2 round trips, got %d", callCount)
+	}
+	if !errors.Is(notifyErr, syscall.ECONNRESET) {
+		t.Errorf("expected notify with ECONNRESET, got %v", notifyErr)
+	}
+}
+
+// TestWithRetryPolicy_RejectsNon5xx verifies only 5xx statuses can be listed.
+func TestWithRetryPolicy_RejectsNon5xx(t *testing.T) {
+	if _, err := NewClient(WithRetryPolicy(RetryPolicy{StatusCodes: []int{http.StatusNotFound}})); err == nil {
+		t.Error("expected error for non-5xx status code, got nil")
+	}
+}
+
+// TestServerErrorRetryDelay covers the Retry-After forms accepted for 5xx retries.
+func TestServerErrorRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{name: "delta-seconds", retryAfter: "3", wantMin: 3 * time.Second, wantMax: 3*time.Second + 1},
+		{name: "clamped to 120s", retryAfter: "600", wantMin: 120 * time.Second, wantMax: 120*time.Second + 1},
+		{name: "HTTP-date in the past", retryAfter: "Mon, 02 Jan 2006 15:04:05 GMT", wantMin: 0, wantMax: 1},
+		{name: "invalid falls back to exponential", retryAfter: "soon", wantMin: 1 * time.Second, wantMax: 2 * time.Second},
+		{name: "absent falls back to exponential", retryAfter: "", wantMin: 1 * time.Second, wantMax: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: make(http.Header)}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			got := serverErrorRetryDelay(resp, 0)
+			if got < tt.wantMin || got >= tt.wantMax {
+				t.Errorf("serverErrorRetryDelay() = %v, want in [%v, %v)", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+// TestDo_MaxRetryWait stops retrying once the next delay would overrun the
+// budget, and returns an error matching both ErrRetryBudgetExceeded and the
+// last failure.
+func TestDo_MaxRetryWait(t *testing.T) {
+	callCount := 0
+
+	ms, client, teardown := newRetryTestClient(t)
+	defer teardown()
+	client.maxRetryWait = 15 * time.Second
+
+	ms.HandleFunc("/test", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		// Each retry waits 10.5s to 11.5s, so only the first fits in 15s.
+		w.Header().Set("RateLimit-Reset", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Do(req, nil)
+	if !errors.Is(err, ErrRetryBudgetExceeded) {
+		t.Fatalf("expected ErrRetryBudgetExceeded, got %v", err)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected error to also match ErrRateLimited, got %v", err)
+	}
+	var budgetErr *RetryBudgetError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("expected *RetryBudgetError, got %T", err)
+	}
+	if budgetErr.Waited < 10*time.Second || budgetErr.Waited+budgetErr.Delay <= budgetErr.Budget {
+		t.Errorf("unexpected budget accounting: %+v", budgetErr)
+	}
+	if callCount != 2 {
+		t.Errorf("expected 2 calls, got %d", callCount)
+	}
+}
+
+// TestWithMaxRetryWait_RejectsNegative verifies the budget can't be negative.
+func TestWithMaxRetryWait_RejectsNegative(t *testing.T) {
+	if _, err := NewClient(WithMaxRetryWait(-time.Second)); err == nil {
+		t.Error("expected error for negative budget, got nil")
+	}
+}
+
+// TestDo_RetryEventNotify verifies the retry event identifies the request and
+// carries the rate limit headers of the response.
+func TestDo_RetryEventNotify(t *testing.T) {
+	callCount := 0
+
+	ms, client, teardown := newRetryTestClient(t)
+	defer teardown()
+
+	var events []RetryEvent
+	client.retryNotify = func(ev RetryEvent) { events = append(events, ev) }
+	client.maxRetries = 1
+
+	ms.HandleFunc("/v2/organizations/acme/builds", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.Header().Set("RateLimit-Limit", "200")
+		w.Header().Set("RateLimit-Remaining", "0")
+		w.Header().Set("RateLimit-Reset", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "v2/organizations/acme/builds", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	first, last := events[0], events[1]
+	if first.Method != http.MethodGet || first.Path != "/v2/organizations/acme/builds" || first.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("unexpected first event: %+v", first)
+	}
+	if first.Attempt != 1 || !first.Retrying || first.Delay < 2*time.Second {
+		t.Errorf("expected first event to retry after at least 2s, got %+v", first)
+	}
+	if first.Rate.Limit != 200 || first.Rate.Remaining != 0 || first.Rate.Reset != 2*time.Second {
+		t.Errorf("unexpected rate: %+v", first.Rate)
+	}
+	if last.Attempt != 2 || last.Retrying || last.Delay != 0 || last.BudgetExceeded {
+		t.Errorf("expected final event without retry, got %+v", last)
+	}
+}
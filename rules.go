@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -70,6 +71,14 @@ func (rs *RulesService) List(ctx context.Context, org string, opt *RulesListOpti
 	return rules, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (rs *RulesService) ListAll(ctx context.Context, org string, opt *RulesListOptions) iter.Seq2[Rule, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *RulesListOptions) ([]Rule, *Response, error) {
+		return rs.List(ctx, org, opt)
+	})
+}
+
 func (rs *RulesService) Get(ctx context.Context, org, ruleUUID string) (Rule, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/rules/%s", org, ruleUUID)
 	req, err := rs.client.NewRequest(ctx, http.MethodGet, u, nil)
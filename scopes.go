@@ -0,0 +1,358 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// REST API access token scopes.
+//
+// buildkite API docs: https://buildkite.com/docs/apis/managing-api-tokens#token-scopes
+const (
+	ScopeDeletePackages         = "delete_packages"
+	ScopeDeleteRegistries       = "delete_registries"
+	ScopeGraphQL                = "graphql"
+	ScopeReadAgents             = "read_agents"
+	ScopeReadArtifacts          = "read_artifacts"
+	ScopeReadBuildLogs          = "read_build_logs"
+	ScopeReadBuilds             = "read_builds"
+	ScopeReadClusters           = "read_clusters"
+	ScopeReadJobEnv             = "read_job_env"
+	ScopeReadOrganizations      = "read_organizations"
+	ScopeReadPackages           = "read_packages"
+	ScopeReadPipelineTemplates  = "read_pipeline_templates"
+	ScopeReadPipelines          = "read_pipelines"
+	ScopeReadRegistries         = "read_registries"
+	ScopeReadRules              = "read_rules"
+	ScopeReadSecretsDetails     = "read_secrets_details"
+	ScopeReadSuites             = "read_suites"
+	ScopeReadTeams              = "read_teams"
+	ScopeReadUser               = "read_user"
+	ScopeWriteAgents            = "write_agents"
+	ScopeWriteArtifacts         = "write_artifacts"
+	ScopeWriteBuildLogs         = "write_build_logs"
+	ScopeWriteBuilds            = "write_builds"
+	ScopeWriteClusters          = "write_clusters"
+	ScopeWritePackages          = "write_packages"
+	ScopeWritePipelineTemplates = "write_pipeline_templates"
+	ScopeWritePipelines         = "write_pipelines"
+	ScopeWriteRegistries        = "write_registries"
+	ScopeWriteRules             = "write_rules"
+	ScopeWriteSecrets           = "write_secrets"
+	ScopeWriteSuites            = "write_suites"
+	ScopeWriteTeams             = "write_teams"
+)
+
+// methodScopes maps each service method, named by its Client field and
+// method as in "Builds.Create", to the scopes its token needs.
+var methodScopes = map[string][]string{
+	"AccessTokens.Get":    {},
+	"AccessTokens.Revoke": {},
+
+	"Agents.Create":  {ScopeWriteAgents},
+	"Agents.Delete":  {ScopeWriteAgents},
+	"Agents.Get":     {ScopeReadAgents},
+	"Agents.List":    {ScopeReadAgents},
+	"Agents.ListAll": {ScopeReadAgents},
+	"Agents.Pause":   {ScopeWriteAgents},
+	"Agents.Resume":  {ScopeWriteAgents},
+	"Agents.Stop":    {ScopeWriteAgents},
+
+	"Annotations.Create":         {ScopeWriteBuilds},
+	"Annotations.CreateForJob":   {ScopeWriteBuilds},
+	"Annotations.Delete":         {ScopeWriteBuilds},
+	"Annotations.DeleteForJob":   {ScopeWriteBuilds},
+	"Annotations.ListByBuild":    {ScopeReadBuilds},
+	"Annotations.ListByBuildAll": {ScopeReadBuilds},
+	"Annotations.ListByJob":      {ScopeReadBuilds},
+	"Annotations.ListByJobAll":   {ScopeReadBuilds},
+
+	"Artifacts.Delete":                {ScopeWriteArtifacts},
+	"Artifacts.DownloadArtifactByURL": {ScopeReadArtifacts},
+	"Artifacts.Get":                   {ScopeReadArtifacts},
+	"Artifacts.ListByBuild":           {ScopeReadArtifacts},
+	"Artifacts.ListByBuildAll":        {ScopeReadArtifacts},
+	"Artifacts.ListByJob":             {ScopeReadArtifacts},
+	"Artifacts.ListByJobAll":          {ScopeReadArtifacts},
+
+	"BuildTests.List":    {ScopeReadSuites},
+	"BuildTests.ListAll": {ScopeReadSuites},
+
+	"Builds.BulkCancel":        {ScopeReadBuilds, ScopeWriteBuilds},
+	"Builds.BulkRebuild":       {ScopeReadBuilds, ScopeWriteBuilds},
+	"Builds.Cancel":            {ScopeWriteBuilds},
+	"Builds.Create":            {ScopeWriteBuilds},
+	"Builds.CreateAndWait":     {ScopeWriteBuilds, ScopeReadBuilds},
+	"Builds.Get":               {ScopeReadBuilds},
+	"Builds.List":              {ScopeReadBuilds},
+	"Builds.ListAll":           {ScopeReadBuilds},
+	"Builds.ListByOrg":         {ScopeReadBuilds},
+	"Builds.ListByOrgAll":      {ScopeReadBuilds},
+	"Builds.ListByPipeline":    {ScopeReadBuilds},
+	"Builds.ListByPipelineAll": {ScopeReadBuilds},
+	"Builds.Rebuild":           {ScopeWriteBuilds},
+	"Builds.Tree":              {ScopeReadBuilds},
+	"Builds.WaitForBuild":      {ScopeReadBuilds},
+
+	"ClusterMaintainers.Create":  {ScopeWriteClusters},
+	"ClusterMaintainers.Delete":  {ScopeWriteClusters},
+	"ClusterMaintainers.Get":     {ScopeReadClusters},
+	"ClusterMaintainers.List":    {ScopeReadClusters},
+	"ClusterMaintainers.ListAll": {ScopeReadClusters},
+
+	"ClusterQueues.Create":  {ScopeWriteClusters},
+	"ClusterQueues.Delete":  {ScopeWriteClusters},
+	"ClusterQueues.Get":     {ScopeReadClusters},
+	"ClusterQueues.List":    {ScopeReadClusters},
+	"ClusterQueues.ListAll": {ScopeReadClusters},
+	"ClusterQueues.Pause":   {ScopeWriteClusters},
+	"ClusterQueues.Resume":  {ScopeWriteClusters},
+	"ClusterQueues.Update":  {ScopeWriteClusters},
+
+	"ClusterSecrets.Create":      {ScopeWriteSecrets},
+	"ClusterSecrets.Delete":      {ScopeWriteSecrets},
+	"ClusterSecrets.Get":         {ScopeReadSecretsDetails},
+	"ClusterSecrets.List":        {ScopeReadSecretsDetails},
+	"ClusterSecrets.ListAll":     {ScopeReadSecretsDetails},
+	"ClusterSecrets.Update":      {ScopeWriteSecrets},
+	"ClusterSecrets.UpdateValue": {ScopeWriteSecrets},
+
+	"ClusterTokens.Create":  {ScopeWriteClusters},
+	"ClusterTokens.Delete":  {ScopeWriteClusters},
+	"ClusterTokens.Get":     {ScopeReadClusters},
+	"ClusterTokens.List":    {ScopeReadClusters},
+	"ClusterTokens.ListAll": {ScopeReadClusters},
+	"ClusterTokens.Update":  {ScopeWriteClusters},
+
+	"Clusters.Create":  {ScopeWriteClusters},
+	"Clusters.Delete":  {ScopeWriteClusters},
+	"Clusters.Get":     {ScopeReadClusters},
+	"Clusters.List":    {ScopeReadClusters},
+	"Clusters.ListAll": {ScopeReadClusters},
+	"Clusters.Update":  {ScopeWriteClusters},
+
+	"Emojis.List": {ScopeReadOrganizations},
+
+	"FlakyTests.List":    {ScopeReadSuites},
+	"FlakyTests.ListAll": {ScopeReadSuites},
+
+	"GraphQL.Do": {ScopeGraphQL},
+
+	"Jobs.DeleteJobLog":               {ScopeWriteBuildLogs},
+	"Jobs.GetJob":                     {ScopeReadBuilds},
+	"Jobs.GetJobByOrg":                {ScopeReadBuilds},
+	"Jobs.GetJobEnvironmentVariables": {ScopeReadJobEnv},
+	"Jobs.GetJobLog":                  {ScopeReadBuildLogs},
+	"Jobs.JobLogExists":               {ScopeReadBuildLogs},
+	"Jobs.ListByBuild":                {ScopeReadBuilds},
+	"Jobs.ListByBuildAll":             {ScopeReadBuilds},
+	"Jobs.ReprioritizeJob":            {ScopeWriteBuilds},
+	"Jobs.RetryJob":                   {ScopeWriteBuilds},
+	"Jobs.UnblockJob":                 {ScopeWriteBuilds},
+
+	"Members.Get":     {ScopeReadOrganizations},
+	"Members.List":    {ScopeReadOrganizations},
+	"Members.ListAll": {ScopeReadOrganizations},
+
+	"Meta.Get": {},
+
+	"Organizations.Get":     {ScopeReadOrganizations},
+	"Organizations.List":    {ScopeReadOrganizations},
+	"Organizations.ListAll": {ScopeReadOrganizations},
+
+	"PackageRegistriesService.Create":          {ScopeWriteRegistries},
+	"PackageRegistriesService.Delete":          {ScopeDeleteRegistries},
+	"PackageRegistriesService.Get":             {ScopeReadRegistries},
+	"PackageRegistriesService.List":            {ScopeReadRegistries},
+	"PackageRegistriesService.ListPackages":    {ScopeReadPackages},
+	"PackageRegistriesService.ListPackagesAll": {ScopeReadPackages},
+	"PackageRegistriesService.Update":          {ScopeWriteRegistries},
+
+	"PackageRegistryTokensService.Create": {ScopeWriteRegistries},
+	"PackageRegistryTokensService.Delete": {ScopeWriteRegistries},
+	"PackageRegistryTokensService.Get":    {ScopeReadRegistries},
+	"PackageRegistryTokensService.List":   {ScopeReadRegistries},
+	"PackageRegistryTokensService.Update": {ScopeWriteRegistries},
+
+	"PackagesService.Copy":                   {ScopeWritePackages},
+	"PackagesService.Create":                 {ScopeWritePackages},
+	"PackagesService.Delete":                 {ScopeDeletePackages},
+	"PackagesService.Get":                    {ScopeReadPackages},
+	"PackagesService.RequestPresignedUpload": {ScopeWritePackages},
+
+	"PipelineSchedules.Create":  {ScopeWritePipelines},
+	"PipelineSchedules.Delete":  {ScopeWritePipelines},
+	"PipelineSchedules.Get":     {ScopeReadPipelines},
+	"PipelineSchedules.List":    {ScopeReadPipelines},
+	"PipelineSchedules.ListAll": {ScopeReadPipelines},
+	"PipelineSchedules.Update":  {ScopeWritePipelines},
+
+	"PipelineTemplates.Create":  {ScopeWritePipelineTemplates},
+	"PipelineTemplates.Delete":  {ScopeWritePipelineTemplates},
+	"PipelineTemplates.Get":     {ScopeReadPipelineTemplates},
+	"PipelineTemplates.List":    {ScopeReadPipelineTemplates},
+	"PipelineTemplates.ListAll": {ScopeReadPipelineTemplates},
+	"PipelineTemplates.Update":  {ScopeWritePipelineTemplates},
+
+	"Pipelines.AddWebhook": {ScopeWritePipelines},
+	"Pipelines.Archive":    {ScopeWritePipelines},
+	"Pipelines.Create":     {ScopeWritePipelines},
+	"Pipelines.Delete":     {ScopeWritePipelines},
+	"Pipelines.Get":        {ScopeReadPipelines},
+	"Pipelines.List":       {ScopeReadPipelines},
+	"Pipelines.ListAll":    {ScopeReadPipelines},
+	"Pipelines.Unarchive":  {ScopeWritePipelines},
+	"Pipelines.Update":     {ScopeWritePipelines},
+
+	"RateLimit.Get": {},
+
+	"Rules.Create":  {ScopeWriteRules},
+	"Rules.Delete":  {ScopeWriteRules},
+	"Rules.Get":     {ScopeReadRules},
+	"Rules.List":    {ScopeReadRules},
+	"Rules.ListAll": {ScopeReadRules},
+
+	"StepUploads.Get":            {ScopeReadBuilds},
+	"StepUploads.ListByBuild":    {ScopeReadBuilds},
+	"StepUploads.ListByBuildAll": {ScopeReadBuilds},
+
+	"TeamMember.CreateTeamMember":   {ScopeWriteTeams},
+	"TeamMember.DeleteTeamMember":   {ScopeWriteTeams},
+	"TeamMember.GetTeamMember":      {ScopeReadTeams},
+	"TeamMember.ListTeamMembers":    {ScopeReadTeams},
+	"TeamMember.ListTeamMembersAll": {ScopeReadTeams},
+	"TeamMember.UpdateTeamMember":   {ScopeWriteTeams},
+
+	"TeamPipelines.Create":  {ScopeWriteTeams},
+	"TeamPipelines.Delete":  {ScopeWriteTeams},
+	"TeamPipelines.Get":     {ScopeReadTeams},
+	"TeamPipelines.List":    {ScopeReadTeams},
+	"TeamPipelines.ListAll": {ScopeReadTeams},
+	"TeamPipelines.Update":  {ScopeWriteTeams},
+
+	"TeamSuites.Create":  {ScopeWriteTeams},
+	"TeamSuites.Delete":  {ScopeWriteTeams},
+	"TeamSuites.Get":     {ScopeReadTeams},
+	"TeamSuites.List":    {ScopeReadTeams},
+	"TeamSuites.ListAll": {ScopeReadTeams},
+	"TeamSuites.Update":  {ScopeWriteTeams},
+
+	"Teams.CreateTeam": {ScopeWriteTeams},
+	"Teams.DeleteTeam": {ScopeWriteTeams},
+	"Teams.GetTeam":    {ScopeReadTeams},
+	"Teams.List":       {ScopeReadTeams},
+	"Teams.ListAll":    {ScopeReadTeams},
+	"Teams.UpdateTeam": {ScopeWriteTeams},
+
+	"TestRuns.Get":                 {ScopeReadSuites},
+	"TestRuns.GetFailedExecutions": {ScopeReadSuites},
+	"TestRuns.List":                {ScopeReadSuites},
+	"TestRuns.ListAll":             {ScopeReadSuites},
+
+	"TestSuites.Create":  {ScopeWriteSuites},
+	"TestSuites.Delete":  {ScopeWriteSuites},
+	"TestSuites.Get":     {ScopeReadSuites},
+	"TestSuites.List":    {ScopeReadSuites},
+	"TestSuites.ListAll": {ScopeReadSuites},
+	"TestSuites.Update":  {ScopeWriteSuites},
+
+	"Tests.Find":    {ScopeReadSuites},
+	"Tests.Get":     {ScopeReadSuites},
+	"Tests.List":    {ScopeReadSuites},
+	"Tests.ListAll": {ScopeReadSuites},
+
+	"User.CurrentUser": {ScopeReadUser},
+}
+
+// RequiredScopes returns the scopes a token needs to call the given service
+// methods, named by their Client field and method, such as "Builds.Create" or
+// "ClusterQueues.List". It returns an error naming any method it doesn't know.
+func RequiredScopes(methods ...string) ([]string, error) {
+	var scopes, unknown []string
+	for _, m := range methods {
+		s, ok := methodScopes[m]
+		if !ok {
+			unknown = append(unknown, m)
+			continue
+		}
+		scopes = append(scopes, s...)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown methods for scope check: %s", strings.Join(unknown, ", "))
+	}
+
+	slices.Sort(scopes)
+	return slices.Compact(scopes), nil
+}
+
+// MissingScopesError is returned by CheckScopes when the client's token lacks
+// scopes that the checked methods need.
+type MissingScopesError struct {
+	// Scopes are the missing scopes, sorted.
+	Scopes []string
+
+	// Methods maps each checked method that can't be called to the scopes it
+	// is missing.
+	Methods map[string][]string
+}
+
+func (e *MissingScopesError) Error() string {
+	methods := make([]string, 0, len(e.Methods))
+	for m := range e.Methods {
+		methods = append(methods, m)
+	}
+	slices.Sort(methods)
+	return fmt.Sprintf("access token is missing scopes %s, needed by %s",
+		strings.Join(e.Scopes, ", "), strings.Join(methods, ", "))
+}
+
+// CheckScopes reports whether the client's token has the scopes needed to
+// call the given service methods, named as for RequiredScopes, so a script
+// can fail before it starts work rather than with a 403 part way through. It
+// fetches the token's scopes with AccessTokens.Get, and returns a
+// *MissingScopesError listing any that are missing.
+func (c *Client) CheckScopes(ctx context.Context, methods ...string) error {
+	if _, err := RequiredScopes(methods...); err != nil {
+		return err
+	}
+
+	token, _, err := c.AccessTokens.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching access token scopes: %w", err)
+	}
+
+	missing := &MissingScopesError{Methods: map[string][]string{}}
+	for _, m := range methods {
+		for _, scope := range methodScopes[m] {
+			if slices.Contains(token.Scopes, scope) || slices.Contains(missing.Methods[m], scope) {
+				continue
+			}
+			missing.Methods[m] = append(missing.Methods[m], scope)
+			missing.Scopes = append(missing.Scopes, scope)
+		}
+	}
+	if len(missing.Methods) == 0 {
+		return nil
+	}
+
+	slices.Sort(missing.Scopes)
+	missing.Scopes = slices.Compact(missing.Scopes)
+	return missing
+}
+
+// WithRequiredScopes makes NewClient check that the token has the scopes
+// needed to call the given service methods, named as for RequiredScopes, and
+// fail with a *MissingScopesError if it doesn't. This makes NewClient send a
+// request to the API; to check with a context, call Client.CheckScopes
+// instead.
+func WithRequiredScopes(methods ...string) ClientOpt {
+	return func(c *Client) error {
+		if _, err := RequiredScopes(methods...); err != nil {
+			return err
+		}
+		c.requiredScopes = append(c.requiredScopes, methods...)
+		return nil
+	}
+}
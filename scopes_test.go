@@ -0,0 +1,120 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestMethodScopes_Complete checks that every exported method of every
+// service on Client is in the scope catalog, and nothing else is.
+func TestMethodScopes_Complete(t *testing.T) {
+	t.Parallel()
+
+	known := map[string]bool{}
+	clientType := reflect.TypeFor[Client]()
+	for i := range clientType.NumField() {
+		field := clientType.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Pointer || !strings.HasSuffix(field.Type.Elem().Name(), "Service") {
+			continue
+		}
+		for j := range field.Type.NumMethod() {
+			name := field.Name + "." + field.Type.Method(j).Name
+			known[name] = true
+			if _, ok := methodScopes[name]; !ok {
+				t.Errorf("methodScopes has no entry for %s", name)
+			}
+		}
+	}
+
+	for name := range methodScopes {
+		if !known[name] {
+			t.Errorf("methodScopes has an entry for unknown method %s", name)
+		}
+	}
+}
+
+func TestRequiredScopes(t *testing.T) {
+	t.Parallel()
+
+	got, err := RequiredScopes("Builds.Create", "Builds.Get", "Jobs.GetJobLog", "Meta.Get")
+	if err != nil {
+		t.Fatalf("RequiredScopes: %v", err)
+	}
+	want := []string{ScopeReadBuildLogs, ScopeReadBuilds, ScopeWriteBuilds}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RequiredScopes diff: (-want +got)\n%s", diff)
+	}
+
+	if _, err := RequiredScopes("Builds.Get", "Builds.Explode"); err == nil || !strings.Contains(err.Error(), "Builds.Explode") {
+		t.Errorf("RequiredScopes with an unknown method error = %v, want one naming it", err)
+	}
+}
+
+func TestClient_CheckScopes(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/access-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(`{"uuid":"b63254c0","scopes":["read_builds","read_pipelines"]}`))
+	})
+
+	if err := client.CheckScopes(context.Background(), "Builds.List", "Pipelines.Get"); err != nil {
+		t.Errorf("CheckScopes with granted scopes = %v, want nil", err)
+	}
+
+	err := client.CheckScopes(context.Background(), "Builds.List", "Builds.Create", "Builds.Cancel", "Jobs.GetJobLog")
+
+	var missing *MissingScopesError
+	if !errors.As(err, &missing) {
+		t.Fatalf("CheckScopes error = %v, want *MissingScopesError", err)
+	}
+	want := &MissingScopesError{
+		Scopes: []string{ScopeReadBuildLogs, ScopeWriteBuilds},
+		Methods: map[string][]string{
+			"Builds.Create":  {ScopeWriteBuilds},
+			"Builds.Cancel":  {ScopeWriteBuilds},
+			"Jobs.GetJobLog": {ScopeReadBuildLogs},
+		},
+	}
+	if diff := cmp.Diff(want, missing); diff != "" {
+		t.Errorf("MissingScopesError diff: (-want +got)\n%s", diff)
+	}
+	wantMsg := "access token is missing scopes read_build_logs, write_builds, needed by Builds.Cancel, Builds.Create, Jobs.GetJobLog"
+	if err.Error() != wantMsg {
+		t.Errorf("Error() = %q, want %q", err.Error(), wantMsg)
+	}
+}
+
+func TestWithRequiredScopes(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	ms.HandleFunc("/v2/access-token", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"scopes":["read_builds"]}`))
+	})
+
+	if _, err := NewClient(WithBaseURL(client.BaseURL.String()), WithRequiredScopes("Builds.Get")); err != nil {
+		t.Errorf("NewClient with granted scopes = %v, want nil", err)
+	}
+
+	_, err := NewClient(WithBaseURL(client.BaseURL.String()), WithRequiredScopes("Agents.Stop"))
+	var missing *MissingScopesError
+	if !errors.As(err, &missing) {
+		t.Errorf("NewClient with missing scopes error = %v, want *MissingScopesError", err)
+	}
+
+	if _, err := NewClient(WithRequiredScopes("Nope.Nope")); err == nil {
+		t.Error("NewClient with an unknown method returned no error")
+	}
+}
@@ -0,0 +1,197 @@
+package buildkite
+
+import "slices"
+
+// BuildState is the state of a build. States the API adds after this package
+// was written decode as is, and are neither terminal, failures nor active.
+type BuildState string
+
+// Build states, as documented at https://buildkite.com/docs/pipelines/configure/defining-steps#build-states
+const (
+	BuildStateCreating  BuildState = "creating"
+	BuildStateScheduled BuildState = "scheduled"
+	BuildStateRunning   BuildState = "running"
+	BuildStatePassed    BuildState = "passed"
+	BuildStateFailing   BuildState = "failing"
+	BuildStateFailed    BuildState = "failed"
+	BuildStateBlocked   BuildState = "blocked"
+	BuildStateCanceling BuildState = "canceling"
+	BuildStateCanceled  BuildState = "canceled"
+	BuildStateSkipped   BuildState = "skipped"
+	BuildStateNotRun    BuildState = "not_run"
+
+	// BuildStateFinished is only a filter for BuildsListOptions.State,
+	// matching builds that are passed, failed, blocked or canceled.
+	BuildStateFinished BuildState = "finished"
+)
+
+// buildStateTransitions lists the states a build may move to from each state.
+// A finished build returns to running when one of its jobs is retried, and a
+// blocked build when it is unblocked.
+var buildStateTransitions = map[BuildState][]BuildState{
+	BuildStateCreating:  {BuildStateScheduled, BuildStateCanceled},
+	BuildStateScheduled: {BuildStateRunning, BuildStateCanceling, BuildStateCanceled, BuildStateSkipped, BuildStateNotRun},
+	BuildStateRunning:   {BuildStatePassed, BuildStateFailing, BuildStateFailed, BuildStateBlocked, BuildStateCanceling},
+	BuildStateFailing:   {BuildStateFailed, BuildStateCanceling},
+	BuildStateBlocked:   {BuildStateRunning, BuildStateCanceled},
+	BuildStateCanceling: {BuildStateCanceled},
+	BuildStatePassed:    {BuildStateRunning},
+	BuildStateFailed:    {BuildStateRunning},
+	BuildStateCanceled:  {BuildStateRunning},
+}
+
+// IsTerminal reports whether a build in state s has finished: passed,
+// failed, canceled, skipped or not run, or blocked. Blocked builds count as
+// finished, as for the API's "finished" filter, although unblocking one
+// resumes it.
+func (s BuildState) IsTerminal() bool {
+	switch s {
+	case BuildStatePassed, BuildStateFailed, BuildStateCanceled, BuildStateSkipped, BuildStateNotRun, BuildStateBlocked:
+		return true
+	}
+	return false
+}
+
+// IsFailure reports whether a build in state s has failed or is failing.
+func (s BuildState) IsFailure() bool {
+	return s == BuildStateFailed || s == BuildStateFailing
+}
+
+// IsActive reports whether a build in state s is still in progress.
+func (s BuildState) IsActive() bool {
+	switch s {
+	case BuildStateCreating, BuildStateScheduled, BuildStateRunning, BuildStateFailing, BuildStateCanceling:
+		return true
+	}
+	return false
+}
+
+// Transitions returns the states a build may move to from state s.
+func (s BuildState) Transitions() []BuildState {
+	return slices.Clone(buildStateTransitions[s])
+}
+
+// CanTransitionTo reports whether a build may move from state s to state t.
+func (s BuildState) CanTransitionTo(t BuildState) bool {
+	return slices.Contains(buildStateTransitions[s], t)
+}
+
+// JobState is the state of a job. States the API adds after this package was
+// written decode as is, and are neither terminal, failures nor active.
+type JobState string
+
+// Job states, as documented at https://buildkite.com/docs/pipelines/configure/defining-steps#job-states
+const (
+	JobStatePending         JobState = "pending"
+	JobStateWaiting         JobState = "waiting"
+	JobStateWaitingFailed   JobState = "waiting_failed"
+	JobStateBlocked         JobState = "blocked"
+	JobStateBlockedFailed   JobState = "blocked_failed"
+	JobStateUnblocked       JobState = "unblocked"
+	JobStateUnblockedFailed JobState = "unblocked_failed"
+	JobStateLimiting        JobState = "limiting"
+	JobStateLimited         JobState = "limited"
+	JobStateScheduled       JobState = "scheduled"
+	JobStateAssigned        JobState = "assigned"
+	JobStateAccepted        JobState = "accepted"
+	JobStateRunning         JobState = "running"
+	JobStatePassed          JobState = "passed"
+	JobStateFailed          JobState = "failed"
+	JobStateFinished        JobState = "finished"
+	JobStateCanceling       JobState = "canceling"
+	JobStateCanceled        JobState = "canceled"
+	JobStateTimingOut       JobState = "timing_out"
+	JobStateTimedOut        JobState = "timed_out"
+	JobStateSkipped         JobState = "skipped"
+	JobStateBroken          JobState = "broken"
+	JobStateExpired         JobState = "expired"
+)
+
+// jobStateTransitions lists the states a job may move to from each state.
+var jobStateTransitions = map[JobState][]JobState{
+	JobStatePending:   {JobStateWaiting, JobStateBlocked, JobStateLimiting, JobStateScheduled, JobStateCanceled, JobStateSkipped, JobStateBroken},
+	JobStateWaiting:   {JobStateScheduled, JobStateBlocked, JobStateLimiting, JobStateWaitingFailed, JobStateCanceled, JobStateSkipped, JobStateBroken, JobStateFinished},
+	JobStateBlocked:   {JobStateUnblocked, JobStateBlockedFailed, JobStateCanceled},
+	JobStateLimiting:  {JobStateLimited, JobStateScheduled, JobStateCanceled},
+	JobStateLimited:   {JobStateScheduled, JobStateCanceled},
+	JobStateScheduled: {JobStateAssigned, JobStateCanceled, JobStateExpired, JobStateSkipped},
+	JobStateAssigned:  {JobStateAccepted, JobStateScheduled, JobStateCanceled},
+	JobStateAccepted:  {JobStateRunning, JobStateScheduled, JobStateCanceled},
+	JobStateRunning:   {JobStatePassed, JobStateFailed, JobStateFinished, JobStateCanceling, JobStateTimingOut},
+	JobStateCanceling: {JobStateCanceled},
+	JobStateTimingOut: {JobStateTimedOut},
+}
+
+// IsTerminal reports whether a job in state s has finished, whether it ran
+// or not.
+func (s JobState) IsTerminal() bool {
+	switch s {
+	case JobStatePassed, JobStateFailed, JobStateFinished, JobStateCanceled, JobStateTimedOut,
+		JobStateSkipped, JobStateBroken, JobStateExpired,
+		JobStateWaitingFailed, JobStateBlockedFailed, JobStateUnblocked, JobStateUnblockedFailed:
+		return true
+	}
+	return false
+}
+
+// IsFailure reports whether a job in state s has failed, including by timing
+// out, expiring before an agent took it, or being unable to run.
+func (s JobState) IsFailure() bool {
+	switch s {
+	case JobStateFailed, JobStateTimedOut, JobStateExpired, JobStateBroken,
+		JobStateWaitingFailed, JobStateBlockedFailed, JobStateUnblockedFailed:
+		return true
+	}
+	return false
+}
+
+// IsActive reports whether a job in state s has been scheduled to run on an
+// agent and hasn't finished.
+func (s JobState) IsActive() bool {
+	switch s {
+	case JobStateScheduled, JobStateAssigned, JobStateAccepted, JobStateRunning, JobStateCanceling, JobStateTimingOut:
+		return true
+	}
+	return false
+}
+
+// Transitions returns the states a job may move to from state s.
+func (s JobState) Transitions() []JobState {
+	return slices.Clone(jobStateTransitions[s])
+}
+
+// CanTransitionTo reports whether a job may move from state s to state t.
+func (s JobState) CanTransitionTo(t JobState) bool {
+	return slices.Contains(jobStateTransitions[s], t)
+}
+
+// JobType is the kind of step a job runs.
+type JobType string
+
+const (
+	JobTypeScript  JobType = "script"  // a command step
+	JobTypeWaiter  JobType = "waiter"  // a wait step
+	JobTypeManual  JobType = "manual"  // a block or input step
+	JobTypeTrigger JobType = "trigger" // a trigger step
+)
+
+// ArtifactState is the state of an artifact's upload.
+type ArtifactState string
+
+const (
+	ArtifactStateNew      ArtifactState = "new"
+	ArtifactStateError    ArtifactState = "error"
+	ArtifactStateFinished ArtifactState = "finished"
+	ArtifactStateDeleted  ArtifactState = "deleted"
+	ArtifactStateExpired  ArtifactState = "expired"
+)
+
+// StepUploadState is the state of a step upload.
+type StepUploadState string
+
+const (
+	// StepUploadStatePending is an upload that hasn't been processed yet.
+	StepUploadStatePending  StepUploadState = "pending"
+	StepUploadStateApplied  StepUploadState = "applied"
+	StepUploadStateRejected StepUploadState = "rejected"
+)
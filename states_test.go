@@ -0,0 +1,136 @@
+package buildkite
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildState_Predicates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		state                     BuildState
+		terminal, failure, active bool
+	}{
+		{BuildStateCreating, false, false, true},
+		{BuildStateScheduled, false, false, true},
+		{BuildStateRunning, false, false, true},
+		{BuildStateFailing, false, true, true},
+		{BuildStateCanceling, false, false, true},
+		{BuildStatePassed, true, false, false},
+		{BuildStateFailed, true, true, false},
+		{BuildStateBlocked, true, false, false},
+		{BuildStateCanceled, true, false, false},
+		{BuildStateSkipped, true, false, false},
+		{BuildStateNotRun, true, false, false},
+		{"some_new_state", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			if got := tt.state.IsTerminal(); got != tt.terminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.terminal)
+			}
+			if got := tt.state.IsFailure(); got != tt.failure {
+				t.Errorf("IsFailure() = %v, want %v", got, tt.failure)
+			}
+			if got := tt.state.IsActive(); got != tt.active {
+				t.Errorf("IsActive() = %v, want %v", got, tt.active)
+			}
+		})
+	}
+}
+
+func TestJobState_Predicates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		state                     JobState
+		terminal, failure, active bool
+	}{
+		{JobStatePending, false, false, false},
+		{JobStateWaiting, false, false, false},
+		{JobStateBlocked, false, false, false},
+		{JobStateLimited, false, false, false},
+		{JobStateScheduled, false, false, true},
+		{JobStateRunning, false, false, true},
+		{JobStateTimingOut, false, false, true},
+		{JobStatePassed, true, false, false},
+		{JobStateFailed, true, true, false},
+		{JobStateTimedOut, true, true, false},
+		{JobStateWaitingFailed, true, true, false},
+		{JobStateUnblocked, true, false, false},
+		{JobStateCanceled, true, false, false},
+		{JobStateSkipped, true, false, false},
+		{"some_new_state", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			if got := tt.state.IsTerminal(); got != tt.terminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.terminal)
+			}
+			if got := tt.state.IsFailure(); got != tt.failure {
+				t.Errorf("IsFailure() = %v, want %v", got, tt.failure)
+			}
+			if got := tt.state.IsActive(); got != tt.active {
+				t.Errorf("IsActive() = %v, want %v", got, tt.active)
+			}
+		})
+	}
+}
+
+// TestStateTransitions checks that active states can move on and that the
+// transition tables only lead to documented states.
+func TestStateTransitions(t *testing.T) {
+	t.Parallel()
+
+	for from, to := range buildStateTransitions {
+		for _, s := range to {
+			if _, ok := buildStateTransitions[s]; !ok && !s.IsTerminal() {
+				t.Errorf("build state %s -> %s leads to a state that can't move on", from, s)
+			}
+		}
+	}
+	for from, to := range jobStateTransitions {
+		if from.IsTerminal() {
+			t.Errorf("terminal job state %s has transitions", from)
+		}
+		for _, s := range to {
+			if _, ok := jobStateTransitions[s]; !ok && !s.IsTerminal() {
+				t.Errorf("job state %s -> %s leads to a state that can't move on", from, s)
+			}
+		}
+	}
+
+	if !BuildStateRunning.CanTransitionTo(BuildStatePassed) || BuildStatePassed.CanTransitionTo(BuildStateFailed) {
+		t.Error("unexpected build state transitions from running or passed")
+	}
+	if !JobStateRunning.CanTransitionTo(JobStateTimingOut) || JobStatePassed.CanTransitionTo(JobStateRunning) {
+		t.Error("unexpected job state transitions from running or passed")
+	}
+}
+
+func TestStates_JSONRoundTripsUnknown(t *testing.T) {
+	t.Parallel()
+
+	in := `{"state":"some_new_state","jobs":[{"type":"some_new_type","state":"some_other_state"}]}`
+	var build Build
+	if err := json.Unmarshal([]byte(in), &build); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if build.State != "some_new_state" || build.Jobs[0].State != "some_other_state" || build.Jobs[0].Type != "some_new_type" {
+		t.Errorf("unexpected decoded states: %+v", build)
+	}
+
+	out, err := json.Marshal(Build{State: build.State, Jobs: []Job{{Type: build.Jobs[0].Type, State: build.Jobs[0].State}}})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got, want map[string]any
+	_ = json.Unmarshal(out, &got)
+	_ = json.Unmarshal([]byte(in), &want)
+	if got["state"] != want["state"] {
+		t.Errorf("round-tripped state = %v, want %v", got["state"], want["state"])
+	}
+}
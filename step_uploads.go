@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
@@ -13,7 +14,7 @@ import (
 // build runs.
 //
 // Step uploads retrieval are only available while the build is within its maximum
-// lifetime; older builds return 410 Gone.
+// lifetime; older builds return 410 Gone, which matches ErrGone.
 type StepUploadsService struct {
 	client *Client
 }
@@ -30,18 +31,18 @@ type StepUploadsService struct {
 // DefinitionYAMLOmitted is true. DefinitionBytes is the exact serialized
 // size of the stored definition.
 type StepUpload struct {
-	UUID                 string     `json:"uuid,omitempty"`
-	GraphQLID            string     `json:"graphql_id,omitempty"`
-	State                string     `json:"state,omitempty"`
-	Source               string     `json:"source,omitempty"`
-	SourceJobID          string     `json:"source_job_id,omitempty"`
-	ReplaceExistingSteps bool       `json:"replace_existing_steps"`
-	CreatedJobsCount     *int       `json:"created_jobs_count,omitempty"`
-	RejectionType        *string    `json:"rejection_type,omitempty"`
-	Message              *string    `json:"message,omitempty"`
-	URL                  string     `json:"url,omitempty"`
-	CreatedAt            *Timestamp `json:"created_at,omitempty"`
-	ProcessedAt          *Timestamp `json:"processed_at,omitempty"`
+	UUID                 string          `json:"uuid,omitempty"`
+	GraphQLID            string          `json:"graphql_id,omitempty"`
+	State                StepUploadState `json:"state,omitempty"`
+	Source               string          `json:"source,omitempty"`
+	SourceJobID          string          `json:"source_job_id,omitempty"`
+	ReplaceExistingSteps bool            `json:"replace_existing_steps"`
+	CreatedJobsCount     *int            `json:"created_jobs_count,omitempty"`
+	RejectionType        *string         `json:"rejection_type,omitempty"`
+	Message              *string         `json:"message,omitempty"`
+	URL                  string          `json:"url,omitempty"`
+	CreatedAt            *Timestamp      `json:"created_at,omitempty"`
+	ProcessedAt          *Timestamp      `json:"processed_at,omitempty"`
 
 	// Present on Get only.
 	DefinitionBytes       *int    `json:"definition_bytes,omitempty"`
@@ -121,6 +122,17 @@ func (s *StepUploadsService) ListByBuild(ctx context.Context, org, pipeline, bui
 	return uploads, resp, err
 }
 
+// ListByBuildAll is like ListByBuild but returns an iterator over the step
+// uploads on every page. Iteration moves forward through Links.Next, or
+// backward through Links.Previous when opt.Before is set.
+func (s *StepUploadsService) ListByBuildAll(ctx context.Context, org, pipeline, buildNumber string, opt *StepUploadsListOptions) iter.Seq2[StepUpload, error] {
+	backward := opt != nil && opt.Before != ""
+	return allCursorPages(ctx, opt, backward, func(ctx context.Context, opt *StepUploadsListOptions) ([]StepUpload, StepUploadsListLink, StepUploadsListLink, error) {
+		uploads, _, err := s.ListByBuild(ctx, org, pipeline, buildNumber, opt)
+		return uploads.Items, uploads.Links.Previous, uploads.Links.Next, err
+	})
+}
+
 // Get returns a single step upload, including its uploaded definition
 // rendered as YAML (subject to the API's render limit — see StepUpload).
 func (s *StepUploadsService) Get(ctx context.Context, org, pipeline, buildNumber, uploadUUID string) (StepUpload, *Response, error) {
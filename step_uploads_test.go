@@ -111,6 +111,41 @@ func TestStepUploadsService_ListByBuild(t *testing.T) {
 	}
 }
 
+func TestStepUploadsService_ListByBuildAll(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/my-great-org/pipelines/sup-keith/builds/123/step-uploads", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, _ = fmt.Fprint(w, `{"items":[{"uuid":"upload-2"}],"links":{"next":"https://api.buildkite.com/v2/organizations/my-great-org/pipelines/sup-keith/builds/123/step-uploads?after=abc123&per_page=1"}}`)
+		case "abc123":
+			if got := r.URL.Query().Get("per_page"); got != "1" {
+				t.Errorf("per_page = %q, want %q", got, "1")
+			}
+			_, _ = fmt.Fprint(w, `{"items":[{"uuid":"upload-1"}],"links":{}}`)
+		default:
+			t.Fatalf("unexpected query %q", r.URL.RawQuery)
+		}
+	})
+
+	var uuids []string
+	for upload, err := range client.StepUploads.ListByBuildAll(context.Background(), "my-great-org", "sup-keith", "123", &StepUploadsListOptions{PerPage: 1}) {
+		if err != nil {
+			t.Fatalf("ListByBuildAll returned error: %v", err)
+		}
+		uuids = append(uuids, upload.UUID)
+	}
+
+	if diff := cmp.Diff(uuids, []string{"upload-2", "upload-1"}); diff != "" {
+		t.Errorf("ListByBuildAll diff: (-got +want)\n%s", diff)
+	}
+}
+
 func TestStepUploadsService_Get(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TeamMemberService handles communication with the teams related
@@ -54,6 +55,14 @@ func (ts *TeamMemberService) ListTeamMembers(ctx context.Context, org string, id
 	return teamMembers, resp, err
 }
 
+// ListTeamMembersAll is like ListTeamMembers but returns an iterator over the
+// results from every page, fetching each page as iteration reaches it.
+func (ts *TeamMemberService) ListTeamMembersAll(ctx context.Context, org string, id string, opt *TeamMembersListOptions) iter.Seq2[TeamMember, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TeamMembersListOptions) ([]TeamMember, *Response, error) {
+		return ts.ListTeamMembers(ctx, org, id, opt)
+	})
+}
+
 // GetTeamMember gets a team member.
 func (ts *TeamMemberService) GetTeamMember(ctx context.Context, org string, teamID string, userID string) (TeamMember, error) {
 	u := fmt.Sprintf("v2/organizations/%s/teams/%s/members/%s", org, teamID, userID)
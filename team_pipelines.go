@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TeamPipelinesService handles communication with the team pipelines related
@@ -57,6 +58,14 @@ func (tps *TeamPipelinesService) List(ctx context.Context, org string, id string
 	return teamPipelines, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (tps *TeamPipelinesService) ListAll(ctx context.Context, org string, id string, opt *TeamPipelinesListOptions) iter.Seq2[TeamPipeline, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TeamPipelinesListOptions) ([]TeamPipeline, *Response, error) {
+		return tps.List(ctx, org, id, opt)
+	})
+}
+
 func (tps *TeamPipelinesService) Get(ctx context.Context, org string, teamID string, pipelineID string) (TeamPipeline, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/teams/%s/pipelines/%s", org, teamID, pipelineID)
 
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TeamSuitesService handles communication with the team pipelines related
@@ -57,6 +58,14 @@ func (tss *TeamSuitesService) List(ctx context.Context, org string, id string, o
 	return TeamSuites, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (tss *TeamSuitesService) ListAll(ctx context.Context, org string, id string, opt *TeamSuitesListOptions) iter.Seq2[TeamSuites, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TeamSuitesListOptions) ([]TeamSuites, *Response, error) {
+		return tss.List(ctx, org, id, opt)
+	})
+}
+
 func (tss *TeamSuitesService) Get(ctx context.Context, org string, teamID string, suiteID string) (TeamSuites, *Response, error) {
 	u := fmt.Sprintf("v2/organizations/%s/teams/%s/suites/%s", org, teamID, suiteID)
 
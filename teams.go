@@ -6,6 +6,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TeamsService handles communication with the teams related
@@ -94,6 +95,14 @@ func (ts *TeamsService) List(ctx context.Context, org string, opt *TeamsListOpti
 	return teams, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (ts *TeamsService) ListAll(ctx context.Context, org string, opt *TeamsListOptions) iter.Seq2[Team, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TeamsListOptions) ([]Team, *Response, error) {
+		return ts.List(ctx, org, opt)
+	})
+}
+
 // GetTeam gets a team.
 func (ts *TeamsService) GetTeam(ctx context.Context, org string, id string) (Team, error) {
 	u := fmt.Sprintf("v2/organizations/%s/teams/%s", org, id)
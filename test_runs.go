@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TestRunsService handles communication with test run related
@@ -79,6 +80,14 @@ func (trs *TestRunsService) List(ctx context.Context, org, slug string, opt *Tes
 	return testRuns, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (trs *TestRunsService) ListAll(ctx context.Context, org, slug string, opt *TestRunsListOptions) iter.Seq2[TestRun, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TestRunsListOptions) ([]TestRun, *Response, error) {
+		return trs.List(ctx, org, slug, opt)
+	})
+}
+
 func (trs *TestRunsService) Get(ctx context.Context, org, slug, runID string) (TestRun, *Response, error) {
 	u := fmt.Sprintf("v2/analytics/organizations/%s/suites/%s/runs/%s", org, slug, runID)
 	req, err := trs.client.NewRequest(ctx, "GET", u, nil)
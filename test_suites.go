@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // TestSuitesService handles communication with the test suite related
@@ -61,6 +62,14 @@ func (tss *TestSuitesService) List(ctx context.Context, org string, opt *TestSui
 	return testSuites, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (tss *TestSuitesService) ListAll(ctx context.Context, org string, opt *TestSuiteListOptions) iter.Seq2[TestSuite, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TestSuiteListOptions) ([]TestSuite, *Response, error) {
+		return tss.List(ctx, org, opt)
+	})
+}
+
 func (tss *TestSuitesService) Get(ctx context.Context, org, slug string) (TestSuite, *Response, error) {
 	u := fmt.Sprintf("v2/analytics/organizations/%s/suites/%s", org, slug)
 	req, err := tss.client.NewRequest(ctx, "GET", u, nil)
@@ -3,6 +3,7 @@ package buildkite
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -133,7 +134,9 @@ func (ts *TestsService) List(ctx context.Context, org, slug string, opt *TestsLi
 	if err != nil {
 		return nil, nil, err
 	}
-	req.Header.Set("Buildkite-Version", testsListAPIVersion)
+	if req.Header.Get("Buildkite-Version") == "" {
+		req.Header.Set("Buildkite-Version", testsListAPIVersion)
+	}
 
 	var tests []TestWithMetrics
 	resp, err := ts.client.Do(req, &tests)
@@ -144,6 +147,14 @@ func (ts *TestsService) List(ctx context.Context, org, slug string, opt *TestsLi
 	return tests, resp, err
 }
 
+// ListAll is like List but returns an iterator over the results from every
+// page, fetching each page as iteration reaches it.
+func (ts *TestsService) ListAll(ctx context.Context, org, slug string, opt *TestsListOptions) iter.Seq2[TestWithMetrics, error] {
+	return allPages(ctx, opt, func(ctx context.Context, opt *TestsListOptions) ([]TestWithMetrics, *Response, error) {
+		return ts.List(ctx, org, slug, opt)
+	})
+}
+
 func (ts *TestsService) Get(ctx context.Context, org, slug, testID string) (Test, *Response, error) {
 	u := fmt.Sprintf("v2/analytics/organizations/%s/suites/%s/tests/%s", org, slug, testID)
 	req, err := ts.client.NewRequest(ctx, "GET", u, nil)
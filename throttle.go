@@ -0,0 +1,106 @@
+package buildkite
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// throttle paces requests once the rate limit budget reported by the API
+// drops to a threshold, so that the remaining requests are spread across the
+// rest of the window instead of running into 429s.
+//
+// The budget is tracked per Client rather than per organization, so a Client
+// shared between organizations paces all of them by whichever responded last.
+type throttle struct {
+	threshold int
+
+	mu   sync.Mutex
+	rate Rate
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newThrottle(threshold int) *throttle {
+	return &throttle{
+		threshold: threshold,
+		now:       time.Now,
+		sleep:     sleepContext,
+	}
+}
+
+// wait blocks until the next request may be sent, reserving one request from
+// the last observed budget.
+func (t *throttle) wait(ctx context.Context) error {
+	t.mu.Lock()
+	d := t.delayLocked()
+	if t.rate.Remaining > 0 {
+		t.rate.Remaining--
+	}
+	t.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+	return t.sleep(ctx, d)
+}
+
+// delayLocked returns how long the next request should wait. Above the
+// threshold requests are not delayed. At or below it, the time left in the
+// window is divided evenly between the remaining requests, and once the budget
+// is spent the request waits for the window to reset.
+func (t *throttle) delayLocked() time.Duration {
+	if t.rate.Limit == 0 || t.rate.Remaining > t.threshold {
+		return 0
+	}
+
+	untilReset := t.rate.ResetAt.Sub(t.now())
+	if untilReset <= 0 {
+		return 0
+	}
+
+	if t.rate.Remaining == 0 {
+		return untilReset
+	}
+	return untilReset / time.Duration(t.rate.Remaining+1)
+}
+
+// update records the budget reported by a response.
+func (t *throttle) update(rate Rate) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rate = rate
+}
+
+// sleepContext sleeps for d, returning early with the context's error if ctx
+// is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pacesRequests reports whether the client paces its own requests against
+// the rate limit budget, because it was created with WithRateLimitThrottle.
+// Code that would otherwise pause for a spent budget should leave it to the
+// client, rather than wait twice.
+func (c *Client) pacesRequests() bool {
+	return c.throttle != nil
+}
+
+// sleep waits for d, returning early with the context's error if ctx is done
+// first. Tests replace the wait with the client's sleepFunc.
+func (c *Client) sleep(ctx context.Context, d time.Duration) error {
+	if c.sleepFunc != nil {
+		c.sleepFunc(d)
+		return ctx.Err()
+	}
+	return sleepContext(ctx, d)
+}
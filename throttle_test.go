@@ -0,0 +1,132 @@
+package buildkite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestThrottle_Delay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.December, 15, 5, 40, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rate Rate
+		want time.Duration
+	}{
+		{
+			name: "no rate observed",
+			rate: Rate{},
+			want: 0,
+		},
+		{
+			name: "above threshold",
+			rate: Rate{Limit: 200, Remaining: 11, ResetAt: now.Add(30 * time.Second)},
+			want: 0,
+		},
+		{
+			name: "at threshold spreads remaining requests over the window",
+			rate: Rate{Limit: 200, Remaining: 9, ResetAt: now.Add(30 * time.Second)},
+			want: 3 * time.Second,
+		},
+		{
+			name: "budget spent waits for reset",
+			rate: Rate{Limit: 200, Remaining: 0, ResetAt: now.Add(30 * time.Second)},
+			want: 30 * time.Second,
+		},
+		{
+			name: "window already reset",
+			rate: Rate{Limit: 200, Remaining: 0, ResetAt: now.Add(-time.Second)},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := newThrottle(10)
+			th.now = func() time.Time { return now }
+			th.update(tt.rate)
+
+			if got := th.delayLocked(); got != tt.want {
+				t.Errorf("delayLocked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWithRateLimitThrottle verifies requests are paced once a response
+// reports the budget at or below the threshold, and that each request
+// reserves one request from the observed budget.
+func TestWithRateLimitThrottle(t *testing.T) {
+	t.Parallel()
+
+	server, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	server.HandleFunc("/v2/organizations/mock-kite/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Limit", "200")
+		w.Header().Set("RateLimit-Remaining", "1")
+		w.Header().Set("RateLimit-Reset", "60")
+		_, _ = fmt.Fprint(w, `[]`)
+	})
+
+	if err := WithRateLimitThrottle(5)(client); err != nil {
+		t.Fatalf("WithRateLimitThrottle: %v", err)
+	}
+
+	var slept []time.Duration
+	client.throttle.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	for range 3 {
+		if _, _, err := client.Pipelines.List(context.Background(), "mock-kite", nil); err != nil {
+			t.Fatalf("Pipelines.List returned error: %v", err)
+		}
+	}
+
+	// The first request has no budget to go on. The second sees 1 remaining
+	// and waits for half the window; the third reserves it again after the
+	// second response reports 1 remaining.
+	if len(slept) != 2 {
+		t.Fatalf("expected 2 throttled requests, got %d (%v)", len(slept), slept)
+	}
+	for _, d := range slept {
+		if d <= 25*time.Second || d > 30*time.Second {
+			t.Errorf("expected a delay of about 30s, got %v", d)
+		}
+	}
+}
+
+func TestWithRateLimitThrottle_Negative(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(WithRateLimitThrottle(-1)); err == nil {
+		t.Error("expected error for negative threshold, got nil")
+	}
+}
+
+// TestThrottle_ContextCanceled verifies a throttled request returns promptly
+// when its context is done.
+func TestThrottle_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	th := newThrottle(0)
+	th.update(Rate{Limit: 200, Remaining: 0, ResetAt: time.Now().Add(time.Hour)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := th.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected prompt return on context deadline, took %v", elapsed)
+	}
+}
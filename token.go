@@ -0,0 +1,217 @@
+package buildkite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the API access token for each request. Implementations
+// must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns the token to send with a request.
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenRefresher is implemented by a TokenSource that caches its token. When
+// a request is rejected with 401 Unauthorized, Refresh is called in place of
+// Token to discard the cached token and fetch the current one.
+type TokenRefresher interface {
+	TokenSource
+
+	// Refresh fetches the current token, bypassing any cached value.
+	Refresh(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc adapts an ordinary function to a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// WithTokenSource configures the buildkite.Client to authenticate with tokens
+// from ts, which is consulted before every HTTP attempt, so a token can be
+// rotated without rebuilding the Client.
+//
+// When a request is rejected with 401 Unauthorized, the token is fetched
+// again (with Refresh, if ts is a TokenRefresher) and, if it has changed, the
+// request is sent once more with the new token. A request whose body can't be
+// replayed is not resent.
+//
+// WithTokenSource replaces any token set with [WithTokenAuth], and vice versa.
+func WithTokenSource(ts TokenSource) ClientOpt {
+	return func(c *Client) error {
+		if ts == nil {
+			return errors.New("token source must not be nil")
+		}
+		c.tokenSource = ts
+		c.authHeader = ""
+		return nil
+	}
+}
+
+// WithOAuthToken configures the buildkite.Client to authenticate as the user
+// who authorized an OAuth access token, such as one from the oauth package.
+// Only bearer tokens are supported.
+func WithOAuthToken(token Token) ClientOpt {
+	return func(c *Client) error {
+		if token.AccessToken == "" {
+			return errors.New("oauth token has no access token")
+		}
+		if token.Type != "" && !strings.EqualFold(token.Type, "bearer") {
+			return fmt.Errorf("unsupported oauth token type %q", token.Type)
+		}
+		return WithTokenAuth(token.AccessToken)(c)
+	}
+}
+
+// StaticToken returns a TokenSource that always returns token.
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+type staticToken string
+
+func (t staticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// EnvToken returns a TokenSource that reads the token from the environment
+// variable name on every request. It returns an error if the variable is
+// unset or empty.
+func EnvToken(name string) TokenSource {
+	return envToken(name)
+}
+
+type envToken string
+
+func (name envToken) Token(context.Context) (string, error) {
+	token := strings.TrimSpace(os.Getenv(string(name)))
+	if token == "" {
+		return "", fmt.Errorf("environment variable %s is not set", string(name))
+	}
+	return token, nil
+}
+
+// FileToken returns a TokenSource that reads the token from the file at path,
+// such as one mounted from a secrets manager. The file is read again whenever
+// its size or modification time changes, and on Refresh. Leading and trailing
+// whitespace is ignored, and an empty file is an error.
+func FileToken(path string) TokenRefresher {
+	return &fileToken{path: path}
+}
+
+type fileToken struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	size    int64
+	modTime time.Time
+}
+
+func (f *fileToken) Token(context.Context) (string, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && info.Size() == f.size && info.ModTime().Equal(f.modTime) {
+		return f.token, nil
+	}
+	return f.readLocked()
+}
+
+func (f *fileToken) Refresh(context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.readLocked()
+}
+
+func (f *fileToken) readLocked() (string, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	defer file.Close()
+
+	// Stat the open file, so the recorded size and modification time match
+	// the contents read even if the file is replaced meanwhile.
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+
+	token := string(bytes.TrimSpace(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", f.path)
+	}
+
+	f.token, f.size, f.modTime = token, info.Size(), info.ModTime()
+	return token, nil
+}
+
+// tokenDoer sets the Authorization header of each request from a TokenSource,
+// and resends a request rejected with 401 once if the token has changed.
+type tokenDoer struct {
+	next   Doer
+	source TokenSource
+}
+
+func (d tokenDoer) Do(req *http.Request) (*http.Response, error) {
+	token, err := d.source.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("fetching token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := d.next.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	var fresh string
+	if r, ok := d.source.(TokenRefresher); ok {
+		fresh, err = r.Refresh(req.Context())
+	} else {
+		fresh, err = d.source.Token(req.Context())
+	}
+	if err != nil || fresh == token {
+		// Surface the original 401 rather than the refresh failure, which is
+		// most likely the same problem.
+		return resp, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		req.Body = body
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	req.Header.Set("Authorization", "Bearer "+fresh)
+	return d.next.Do(req)
+}
@@ -0,0 +1,202 @@
+package buildkite
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithTokenSource_ConsultedPerRequest(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	var current atomic.Value
+	current.Store("first")
+	if err := WithTokenSource(TokenSourceFunc(func(context.Context) (string, error) {
+		return current.Load().(string), nil
+	}))(client); err != nil {
+		t.Fatalf("WithTokenSource: %v", err)
+	}
+
+	var got []string
+	ms.HandleFunc("/v2/user", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	for _, token := range []string{"first", "second"} {
+		current.Store(token)
+		if _, _, err := client.User.CurrentUser(context.Background()); err != nil {
+			t.Fatalf("CurrentUser: %v", err)
+		}
+	}
+
+	want := []string{"Bearer first", "Bearer second"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Authorization headers = %q, want %q", got, want)
+	}
+}
+
+func TestWithTokenSource_RetriesOnceAfter401(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken(t, path, "old")
+	if err := WithTokenSource(FileToken(path))(client); err != nil {
+		t.Fatalf("WithTokenSource: %v", err)
+	}
+
+	var bodies []string
+	ms.HandleFunc("/v2/organizations/acme/pipelines/web/builds", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if r.Header.Get("Authorization") != "Bearer new" {
+			// The token is rotated as the old one is rejected.
+			writeToken(t, path, "new")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Authentication required"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"number":1}`))
+	})
+
+	build, _, err := client.Builds.Create(context.Background(), "acme", "web", CreateBuild{Commit: "HEAD", Branch: "main"})
+	if err != nil {
+		t.Fatalf("Builds.Create: %v", err)
+	}
+	if build.Number != 1 {
+		t.Errorf("build number = %d, want 1", build.Number)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("server calls = %d, want 2", len(bodies))
+	}
+	if bodies[0] == "" || bodies[0] != bodies[1] {
+		t.Errorf("resent body = %q, want %q", bodies[1], bodies[0])
+	}
+}
+
+func TestWithTokenSource_UnchangedTokenNotRetried(t *testing.T) {
+	t.Parallel()
+
+	ms, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	if err := WithTokenSource(StaticToken("revoked"))(client); err != nil {
+		t.Fatalf("WithTokenSource: %v", err)
+	}
+
+	calls := 0
+	ms.HandleFunc("/v2/user", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, _, err := client.User.CurrentUser(context.Background())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("CurrentUser error = %v, want ErrUnauthorized", err)
+	}
+	if calls != 1 {
+		t.Errorf("server calls = %d, want 1", calls)
+	}
+}
+
+func TestWithTokenSource_Error(t *testing.T) {
+	t.Parallel()
+
+	_, client, teardown := newMockServerAndClient(t)
+	t.Cleanup(teardown)
+	if err := WithTokenSource(EnvToken("GO_BUILDKITE_TEST_UNSET_TOKEN"))(client); err != nil {
+		t.Fatalf("WithTokenSource: %v", err)
+	}
+
+	_, _, err := client.User.CurrentUser(context.Background())
+	if err == nil {
+		t.Fatal("CurrentUser returned no error for an unset token variable")
+	}
+}
+
+func TestEnvToken(t *testing.T) {
+	t.Setenv("GO_BUILDKITE_TEST_TOKEN", " abc123\n")
+
+	got, err := EnvToken("GO_BUILDKITE_TEST_TOKEN").Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+	if got != "abc123" {
+		t.Errorf("Token = %q, want %q", got, "abc123")
+	}
+}
+
+func TestFileToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "token")
+	writeToken(t, path, "one\n")
+	ts := FileToken(path)
+
+	assertToken := func(want string) {
+		t.Helper()
+		got, err := ts.Token(ctx)
+		if err != nil {
+			t.Fatalf("Token: %v", err)
+		}
+		if got != want {
+			t.Errorf("Token = %q, want %q", got, want)
+		}
+	}
+
+	assertToken("one")
+
+	writeToken(t, path, "two")
+	assertToken("two")
+
+	// A rewrite that leaves the size and modification time unchanged is only
+	// picked up by Refresh.
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeToken(t, path, "tri")
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	assertToken("two")
+	if got, err := ts.Refresh(ctx); err != nil || got != "tri" {
+		t.Errorf("Refresh = %q, %v, want %q", got, err, "tri")
+	}
+
+	writeToken(t, path, "  \n")
+	if _, err := ts.Token(ctx); err == nil {
+		t.Error("Token returned no error for an empty file")
+	}
+}
+
+func writeToken(t *testing.T, path, token string) {
+	t.Helper()
+
+	// Move the modification time forward, so the change is visible even on
+	// filesystems with coarse timestamps.
+	modTime := time.Now()
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+
+	if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+		t.Fatalf("writing token file: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("touching token file: %v", err)
+	}
+}